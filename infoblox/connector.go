@@ -0,0 +1,198 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// defaultResourceTimeout is the default time limit of every operation of a resource, including retries.
+const defaultResourceTimeout = 10 * time.Minute
+
+// providerConnector is the provider's meta object, passed to every resource and data source.
+// It is the go-client's connector for WAPI requests, extended with provider-wide settings.
+type providerConnector struct {
+	ibclient.IBConnector
+
+	// Settings of the WAPI connection and the requestor shared by all the operations,
+	// used to bind WAPI requests to the context of an operation.
+	hostConfig ibclient.HostConfig
+	authConfig ibclient.AuthConfig
+	requestor  ibclient.HttpRequestor
+
+	// Definitions of extensible attributes, loaded on demand.
+	eaDefs *eaDefinitionCache
+
+	// Extensible attributes to be set on every object created or updated by a resource.
+	defaultEAs map[string]interface{}
+
+	// Names of extensible attributes managed on the NIOS side, which resources must never change;
+	// a name ending with '*' is a prefix.
+	ignoredEAs []string
+}
+
+// providerSettings returns the provider-wide settings out of the provider's meta object.
+// An empty set of settings is returned for a bare go-client's connector.
+func providerSettings(m interface{}) *providerConnector {
+	if conn, ok := m.(*providerConnector); ok {
+		return conn
+	}
+
+	return &providerConnector{}
+}
+
+// withContext returns a copy of the provider's meta object, which sends WAPI requests within the given context,
+// so that they are cancelled together with the terraform operation. WAPI errors are bound to the attributes
+// of the given resource schema, if any, which have caused them.
+func (c *providerConnector) withContext(ctx context.Context, resourceSchema map[string]*schema.Schema) *providerConnector {
+	if c.requestor == nil {
+		return c
+	}
+
+	conn, err := ibclient.NewConnector(
+		c.hostConfig, c.authConfig, ibclient.TransportConfig{},
+		&ibclient.WapiRequestBuilder{}, &contextRequestor{HttpRequestor: c.requestor, ctx: ctx, resourceSchema: resourceSchema})
+	if err != nil {
+		return c
+	}
+
+	res := *c
+	res.IBConnector = conn
+	return &res
+}
+
+// bindRequestContext binds WAPI requests made with the provider's meta object to the given context
+// and to the schema of the resource which makes them.
+func bindRequestContext(ctx context.Context, m interface{}, resourceSchema map[string]*schema.Schema) interface{} {
+	if conn, ok := m.(*providerConnector); ok {
+		return conn.withContext(ctx, resourceSchema)
+	}
+
+	return m
+}
+
+// withRequestContext makes the operations of a resource or a data source send WAPI requests
+// within the context of the operation.
+func withRequestContext(r *schema.Resource) *schema.Resource {
+	wrap := func(op func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(
+		context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+
+		if op == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return op(ctx, d, bindRequestContext(ctx, m, r.Schema))
+		}
+	}
+
+	r.CreateContext = wrap(r.CreateContext)
+	r.ReadContext = wrap(r.ReadContext)
+	r.UpdateContext = wrap(r.UpdateContext)
+	r.DeleteContext = wrap(r.DeleteContext)
+
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		importState := r.Importer.StateContext
+		r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			return importState(ctx, d, bindRequestContext(ctx, m, r.Schema))
+		}
+	}
+
+	return r
+}
+
+// defaultTimeouts returns the default time limits of a resource's operations,
+// which may be changed by a 'timeouts' block of the resource.
+func defaultTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultResourceTimeout),
+		Read:   schema.DefaultTimeout(defaultResourceTimeout),
+		Update: schema.DefaultTimeout(defaultResourceTimeout),
+		Delete: schema.DefaultTimeout(defaultResourceTimeout),
+	}
+}
+
+// withDefaultEAs returns the provider's default extensible attributes merged with the ones
+// specified for a resource. Resource-level values override the default ones.
+func withDefaultEAs(extAttrs map[string]interface{}, m interface{}) map[string]interface{} {
+	defaultEAs := providerSettings(m).defaultEAs
+	if len(defaultEAs) == 0 {
+		return extAttrs
+	}
+
+	res := make(map[string]interface{}, len(defaultEAs)+len(extAttrs))
+	for key, val := range defaultEAs {
+		res[key] = val
+	}
+	for key, val := range extAttrs {
+		res[key] = val
+	}
+
+	return res
+}
+
+// isIgnoredEA checks whether the extensible attribute is managed on the NIOS side
+// and must never be changed by a resource.
+func isIgnoredEA(name string, m interface{}) bool {
+	for _, ignored := range providerSettings(m).ignoredEAs {
+		if prefix, isPrefix := strings.CutSuffix(ignored, "*"); isPrefix {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		} else if name == ignored {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withProviderEAs returns extensible attributes to create an object with: the ones specified for a resource
+// merged with the provider's default ones, except the EAs ignored by the provider.
+func withProviderEAs(extAttrs map[string]interface{}, m interface{}) map[string]interface{} {
+	merged := withDefaultEAs(extAttrs, m)
+	res := make(map[string]interface{}, len(merged))
+	for key, val := range merged {
+		if !isIgnoredEA(key, m) {
+			res[key] = val
+		}
+	}
+
+	return res
+}
+
+// omitProviderEAs omits NIOS-side EAs which are either ignored by the provider
+// or have the values of the provider's default EAs.
+// Should be used when all NIOS-side EAs are put into the state, ex. on import.
+func omitProviderEAs(niosEAs ibclient.EA, m interface{}) ibclient.EA {
+	for key := range niosEAs {
+		if isIgnoredEA(key, m) {
+			delete(niosEAs, key)
+		}
+	}
+
+	return omitDefaultEAs(niosEAs, m)
+}
+
+// omitDefaultEAs omits NIOS-side EAs which have the values of the provider's default extensible attributes.
+// Should be used when all NIOS-side EAs are put into the state, ex. on import.
+func omitDefaultEAs(niosEAs ibclient.EA, m interface{}) ibclient.EA {
+	defaultEAs := providerSettings(m).defaultEAs
+	for key, defaultVal := range defaultEAs {
+		if niosVal, found := niosEAs[key]; found && eaValuesEqual(niosVal, defaultVal) {
+			delete(niosEAs, key)
+		}
+	}
+
+	return niosEAs
+}
+
+// eaValuesEqual compares values of an extensible attribute, regardless of whether they come from NIOS
+// or from a JSON-formatted terraform field (ex. 10 and 10.0, or []string and []interface{}).
+func eaValuesEqual(val1, val2 interface{}) bool {
+	return fmt.Sprint(val1) == fmt.Sprint(val2)
+}
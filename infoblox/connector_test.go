@@ -0,0 +1,161 @@
+package infoblox
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func TestDefaultEAs(t *testing.T) {
+	conn := &providerConnector{
+		defaultEAs: map[string]interface{}{
+			"Owner":      "netops",
+			"CostCenter": 123.0,
+			"Sites":      []interface{}{"HQ", "DC1"},
+		},
+	}
+
+	merged := withDefaultEAs(map[string]interface{}{"Owner": "dns-team", "Location": "HQ"}, conn)
+	expected := map[string]interface{}{
+		"Owner":      "dns-team",
+		"CostCenter": 123.0,
+		"Sites":      []interface{}{"HQ", "DC1"},
+		"Location":   "HQ",
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Fatalf("expected merged EAs %v, got %v", expected, merged)
+	}
+
+	// Values of default EAs, as they come from NIOS, are not put into the state.
+	niosEAs := ibclient.EA{
+		"Owner":      "dns-team",
+		"CostCenter": 123,
+		"Sites":      []string{"HQ", "DC1"},
+		"Location":   "HQ",
+	}
+	omitted := omitDefaultEAs(niosEAs, conn)
+	expectedOmitted := ibclient.EA{"Owner": "dns-team", "Location": "HQ"}
+	if !reflect.DeepEqual(omitted, expectedOmitted) {
+		t.Fatalf("expected EAs %v, got %v", expectedOmitted, omitted)
+	}
+
+	// A bare go-client's connector has no default EAs.
+	extAttrs := map[string]interface{}{"Owner": "dns-team"}
+	if merged = withDefaultEAs(extAttrs, &ibclient.Connector{}); !reflect.DeepEqual(merged, extAttrs) {
+		t.Fatalf("expected EAs %v, got %v", extAttrs, merged)
+	}
+}
+
+func TestIgnoredEAs(t *testing.T) {
+	conn := &providerConnector{
+		defaultEAs: map[string]interface{}{"Owner": "netops", "Discovered Name": "default"},
+		ignoredEAs: []string{"Cloud*", "Discovered Name"},
+	}
+
+	for name, expected := range map[string]bool{
+		"Cloud API Owned": true,
+		"CloudSync":       true,
+		"Discovered Name": true,
+		"Discovered":      false,
+		"Owner":           false,
+	} {
+		if isIgnoredEA(name, conn) != expected {
+			t.Fatalf("expected isIgnoredEA to return %t for '%s'", expected, name)
+		}
+	}
+
+	// Ignored EAs are never written.
+	created := withProviderEAs(map[string]interface{}{"Cloud API Owned": "True", "Site": "HQ"}, conn)
+	expectedCreated := map[string]interface{}{"Owner": "netops", "Site": "HQ"}
+	if !reflect.DeepEqual(created, expectedCreated) {
+		t.Fatalf("expected EAs %v, got %v", expectedCreated, created)
+	}
+
+	// Ignored EAs are neither changed nor removed on update.
+	merged, err := mergeEAs(
+		map[string]interface{}{"Cloud API Owned": "False", "Discovered Name": "host1"},
+		map[string]interface{}{"Cloud API Owned": "True", "Site": "HQ"},
+		map[string]interface{}{"Discovered Name": "host0"},
+		conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMerged := ibclient.EA{"Cloud API Owned": "False", "Discovered Name": "host1", "Owner": "netops", "Site": "HQ"}
+	if !reflect.DeepEqual(merged, expectedMerged) {
+		t.Fatalf("expected EAs %v, got %v", expectedMerged, merged)
+	}
+
+	// Ignored EAs keep their terraform-side values on read.
+	read := omitEAs(
+		map[string]interface{}{"Cloud API Owned": "False", "Site": "HQ", "Owner": "netops"},
+		map[string]interface{}{"Cloud API Owned": "True", "CloudSync": "yes", "Site": "HQ"},
+		conn)
+	expectedRead := map[string]interface{}{"Cloud API Owned": "True", "CloudSync": "yes", "Site": "HQ"}
+	if !reflect.DeepEqual(read, expectedRead) {
+		t.Fatalf("expected EAs %v, got %v", expectedRead, read)
+	}
+
+	// Ignored EAs are not put into the state on import.
+	imported := omitProviderEAs(ibclient.EA{"Cloud API Owned": "False", "Owner": "netops", "Site": "HQ"}, conn)
+	expectedImported := ibclient.EA{"Site": "HQ"}
+	if !reflect.DeepEqual(imported, expectedImported) {
+		t.Fatalf("expected EAs %v, got %v", expectedImported, imported)
+	}
+}
+
+// testContextRequestor records the contexts of the requests sent.
+type testContextRequestor struct {
+	contexts []context.Context
+}
+
+func (tr *testContextRequestor) Init(ibclient.AuthConfig, ibclient.TransportConfig) {}
+
+func (tr *testContextRequestor) SendRequest(req *http.Request) ([]byte, error) {
+	tr.contexts = append(tr.contexts, req.Context())
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+	return []byte(`"view/ZG5z:default/true"`), nil
+}
+
+func TestRequestContext(t *testing.T) {
+	requestor := &testContextRequestor{}
+	conn := &providerConnector{
+		hostConfig: ibclient.HostConfig{Host: "nios.example.com", Port: "443", Version: "2.12.3"},
+		requestor:  requestor,
+		defaultEAs: map[string]interface{}{"Owner": "netops"},
+	}
+
+	resource := withRequestContext(&schema.Resource{
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if providerSettings(m).defaultEAs["Owner"] != "netops" {
+				t.Fatal("expected the provider settings to be kept")
+			}
+			if _, err := m.(ibclient.IBConnector).DeleteObject("view/ZG5z:default/true"); err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+	})
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "delete")
+	if diags := resource.DeleteContext(ctx, nil, conn); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(requestor.contexts) != 1 || requestor.contexts[0].Value(ctxKey{}) != "delete" {
+		t.Fatal("expected the request to be sent within the context of the operation")
+	}
+
+	// Cancelling the operation cancels its requests.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if diags := resource.DeleteContext(ctx, nil, conn); !diags.HasError() {
+		t.Fatal("expected the request to be cancelled")
+	}
+}
@@ -92,7 +92,7 @@ func dataSourceARecordRead(ctx context.Context, d *schema.ResourceData, m interf
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.RecordA{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting A Record failed with filters %v: %s", filters, err.Error()))
+			return diagFromErr(fmt.Errorf("Getting A Record failed with filters %v: %w", filters, err))
 		}
 	}
 
@@ -102,7 +102,7 @@ func dataSourceARecordRead(ctx context.Context, d *schema.ResourceData, m interf
 	for _, r := range res {
 		recordaFlat, err := flattenRecordA(r)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten A Record  : %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten A Record  : %w", err))
 		}
 
 		results = append(results, recordaFlat)
@@ -110,7 +110,7 @@ func dataSourceARecordRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
@@ -93,7 +93,7 @@ func dataSourceAAAARecordRead(ctx context.Context, d *schema.ResourceData, m int
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.RecordAAAA{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting AAAA Record failed : %s", err.Error()))
+			return diagFromErr(fmt.Errorf("Getting AAAA Record failed : %w", err))
 		}
 	}
 
@@ -103,7 +103,7 @@ func dataSourceAAAARecordRead(ctx context.Context, d *schema.ResourceData, m int
 	for _, qa := range res {
 		qarecordFlat, err := flattenRecordAAAA(qa)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten AAAA Record: %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten AAAA Record: %w", err))
 		}
 
 		results = append(results, qarecordFlat)
@@ -111,7 +111,7 @@ func dataSourceAAAARecordRead(ctx context.Context, d *schema.ResourceData, m int
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
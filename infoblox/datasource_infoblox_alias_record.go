@@ -119,14 +119,14 @@ func datasourceAliasRecordRead(ctx context.Context, d *schema.ResourceData, m in
 	for _, r := range res {
 		aliasRecord, err := flattenAliasRecord(r)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten alias record  : %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten alias record  : %w", err))
 		}
 		results = append(results, aliasRecord)
 	}
 
 	err := d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
@@ -0,0 +1,150 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceCAARecord() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCAARecordRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of CAA-records matching filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS view which the record's zone belongs to.",
+						},
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "FQDN for the CAA-record.",
+						},
+						"ca_flag": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Flag of the CAA-record.",
+						},
+						"ca_tag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Property tag of the CAA-record.",
+						},
+						"ca_value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value of the CAA-record.",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone which the record belongs to.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TTL value for the CAA-record.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the CAA-record.",
+						},
+						"ext_attrs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Extensible attributes of the CAA-record, as a map in JSON format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCAARecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	qp := ibclient.NewQueryParams(false, filters)
+
+	var res []ibclient.RecordCaa
+	if err := connector.GetObject(newEmptyCaaRecord(), "", qp, &res); err != nil {
+		return diagFromErr(fmt.Errorf("failed to get CAA-records: %w", err))
+	}
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		rec, err := flattenRecordCAA(r)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("failed to flatten CAA-record: %w", err))
+		}
+		results = append(results, rec)
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diagFromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func flattenRecordCAA(rec ibclient.RecordCaa) (map[string]interface{}, error) {
+	var eaMap map[string]interface{}
+	if rec.Ea != nil && len(rec.Ea) > 0 {
+		eaMap = rec.Ea
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := ttlUndef
+	if rec.Ttl != nil && rec.UseTtl != nil && *rec.UseTtl {
+		ttl = int(*rec.Ttl)
+	}
+	var caFlag int
+	if rec.CaFlag != nil {
+		caFlag = int(*rec.CaFlag)
+	}
+
+	return map[string]interface{}{
+		"id":        rec.Ref,
+		"dns_view":  stringPtrValue(rec.View),
+		"fqdn":      stringPtrValue(rec.Name),
+		"ca_flag":   caFlag,
+		"ca_tag":    stringPtrValue(rec.CaTag),
+		"ca_value":  stringPtrValue(rec.CaValue),
+		"zone":      rec.Zone,
+		"ttl":       ttl,
+		"comment":   stringPtrValue(rec.Comment),
+		"ext_attrs": string(ea),
+	}, nil
+}
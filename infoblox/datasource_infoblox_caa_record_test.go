@@ -0,0 +1,47 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var testAccDataSourceCAARecord = `
+	resource "infoblox_zone_auth" "zone1" {
+		fqdn = "ds-caa.test.com"
+	}
+	resource "infoblox_caa_record" "rec1" {
+		fqdn     = "ds-caa.test.com"
+		ca_tag   = "issuewild"
+		ca_value = ";"
+		comment  = "no wildcard certificates"
+		depends_on = [infoblox_zone_auth.zone1]
+	}
+	data "infoblox_caa_record" "ds1" {
+		filters = {
+			name = "ds-caa.test.com"
+			view = "default"
+		}
+		depends_on = [infoblox_caa_record.rec1]
+	}`
+
+func TestAccDataSourceCAARecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCAARecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCAARecord,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_caa_record.ds1", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_caa_record.ds1", "results.0.fqdn", "ds-caa.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_caa_record.ds1", "results.0.zone", "ds-caa.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_caa_record.ds1", "results.0.ca_tag", "issuewild"),
+					resource.TestCheckResourceAttr("data.infoblox_caa_record.ds1", "results.0.ca_value", ";"),
+					resource.TestCheckResourceAttr("data.infoblox_caa_record.ds1", "results.0.comment", "no wildcard certificates"),
+				),
+			},
+		},
+	})
+}
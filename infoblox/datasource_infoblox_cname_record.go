@@ -93,7 +93,7 @@ func dataSourceCNameRecordRead(ctx context.Context, d *schema.ResourceData, m in
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.RecordCNAME{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting CNAME Record failed with filters %v: %s", filters, err.Error()))
+			return diagFromErr(fmt.Errorf("Getting CNAME Record failed with filters %v: %w", filters, err))
 		}
 	}
 
@@ -103,7 +103,7 @@ func dataSourceCNameRecordRead(ctx context.Context, d *schema.ResourceData, m in
 	for _, cn := range res {
 		recordcnameFlat, err := flattenRecordCNAME(cn)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten CNAME Record : %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten CNAME Record : %w", err))
 		}
 
 		results = append(results, recordcnameFlat)
@@ -111,7 +111,7 @@ func dataSourceCNameRecordRead(ctx context.Context, d *schema.ResourceData, m in
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
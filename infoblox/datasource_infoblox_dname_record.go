@@ -0,0 +1,134 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceDNAMERecord() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNAMERecordRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of DNAME-records matching filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS view which the record's zone belongs to.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The owner name of the DNAME-record.",
+						},
+						"target": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The target domain name the subtree of 'name' is redirected to.",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone which the record belongs to.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TTL value for the DNAME-record.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the DNAME-record.",
+						},
+						"ext_attrs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Extensible attributes of the DNAME-record, as a map in JSON format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDNAMERecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	qp := ibclient.NewQueryParams(false, filters)
+
+	var res []ibclient.RecordDname
+	if err := connector.GetObject(newEmptyDnameRecord(), "", qp, &res); err != nil {
+		return diagFromErr(fmt.Errorf("failed to get DNAME-records: %w", err))
+	}
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		rec, err := flattenRecordDNAME(r)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("failed to flatten DNAME-record: %w", err))
+		}
+		results = append(results, rec)
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diagFromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func flattenRecordDNAME(rec ibclient.RecordDname) (map[string]interface{}, error) {
+	var eaMap map[string]interface{}
+	if rec.Ea != nil && len(rec.Ea) > 0 {
+		eaMap = rec.Ea
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := ttlUndef
+	if rec.Ttl != nil && rec.UseTtl != nil && *rec.UseTtl {
+		ttl = int(*rec.Ttl)
+	}
+
+	return map[string]interface{}{
+		"id":        rec.Ref,
+		"name":      stringPtrValue(rec.Name),
+		"target":    stringPtrValue(rec.Target),
+		"dns_view":  rec.View,
+		"zone":      rec.Zone,
+		"ttl":       ttl,
+		"comment":   stringPtrValue(rec.Comment),
+		"ext_attrs": string(ea),
+	}, nil
+}
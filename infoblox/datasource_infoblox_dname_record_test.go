@@ -0,0 +1,45 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var testAccDataSourceDNAMERecord = `
+	resource "infoblox_zone_auth" "zone1" {
+		fqdn = "ds-dname.test.com"
+	}
+	resource "infoblox_dname_record" "rec1" {
+		name       = "legacy.ds-dname.test.com"
+		target     = "current.example.com"
+		ttl        = 120
+		depends_on = [infoblox_zone_auth.zone1]
+	}
+	data "infoblox_dname_record" "ds1" {
+		filters = {
+			name = "legacy.ds-dname.test.com"
+		}
+		depends_on = [infoblox_dname_record.rec1]
+	}`
+
+func TestAccDataSourceDNAMERecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNAMERecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDNAMERecord,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_dname_record.ds1", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_dname_record.ds1", "results.0.name", "legacy.ds-dname.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_dname_record.ds1", "results.0.zone", "ds-dname.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_dname_record.ds1", "results.0.dns_view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_dname_record.ds1", "results.0.target", "current.example.com"),
+					resource.TestCheckResourceAttr("data.infoblox_dname_record.ds1", "results.0.ttl", "120"),
+				),
+			},
+		},
+	})
+}
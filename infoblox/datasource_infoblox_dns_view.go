@@ -76,7 +76,7 @@ func dataSourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interf
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.View{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting DNS View failed : %s", err.Error()))
+			return diagFromErr(fmt.Errorf("Getting DNS View failed : %w", err))
 		}
 	}
 
@@ -86,7 +86,7 @@ func dataSourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interf
 	for _, r := range res {
 		dnsviewFlat, err := flattenDNSView(r)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten DNS View  : %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten DNS View  : %w", err))
 		}
 
 		results = append(results, dnsviewFlat)
@@ -94,7 +94,7 @@ func dataSourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
@@ -164,14 +164,14 @@ func dataSourceDtcLbdnRecordRead(ctx context.Context, d *schema.ResourceData, m
 	for _, r := range res {
 		dtcLbdn, err := flattenDtcLbdn(r, connector)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten DTC LBDN : %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten DTC LBDN : %w", err))
 		}
 		results = append(results, dtcLbdn)
 	}
 
 	err := d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
@@ -240,7 +240,7 @@ func flattenDtcLbdn(lbdn ibclient.DtcLbdn, connector ibclient.IBConnector) (map[
 		var topology ibclient.DtcTopology
 		err := connector.GetObject(&ibclient.DtcTopology{}, *lbdn.Topology, nil, &topology)
 		if err != nil {
-			return nil, fmt.Errorf("error getting %s DtcTopology object: %s", *lbdn.Topology, err)
+			return nil, fmt.Errorf("error getting %s DtcTopology object: %w", *lbdn.Topology, err)
 		}
 		res["topology"] = *topology.Name
 	}
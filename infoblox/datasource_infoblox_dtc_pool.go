@@ -206,14 +206,14 @@ func dataSourceDtcPoolRecordRead(ctx context.Context, d *schema.ResourceData, m
 	for _, r := range res {
 		dtcPool, err := flattenDtcPool(r, connector)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten DTC Pool : %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten DTC Pool : %w", err))
 		}
 		results = append(results, dtcPool)
 	}
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
@@ -267,7 +267,7 @@ func flattenDtcPool(pool ibclient.DtcPool, connector ibclient.IBConnector) (map[
 		var topology ibclient.DtcTopology
 		err := connector.GetObject(&ibclient.DtcTopology{}, *pool.LbAlternateTopology, nil, &topology)
 		if err != nil {
-			return nil, fmt.Errorf("error getting %s DtcTopology object: %s", *pool.LbAlternateTopology, err)
+			return nil, fmt.Errorf("error getting %s DtcTopology object: %w", *pool.LbAlternateTopology, err)
 		}
 		res["lb_alternate_topology"] = topology.Name
 	}
@@ -289,7 +289,7 @@ func flattenDtcPool(pool ibclient.DtcPool, connector ibclient.IBConnector) (map[
 		var topology ibclient.DtcTopology
 		err := connector.GetObject(&ibclient.DtcTopology{}, *pool.LbPreferredTopology, nil, &topology)
 		if err != nil {
-			return nil, fmt.Errorf("error getting %s DtcTopology object: %s", *pool.LbPreferredTopology, err)
+			return nil, fmt.Errorf("error getting %s DtcTopology object: %w", *pool.LbPreferredTopology, err)
 		}
 		res["lb_preferred_topology"] = topology.Name
 	}
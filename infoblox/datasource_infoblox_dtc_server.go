@@ -138,13 +138,13 @@ func dataSourceDtcServerRead(ctx context.Context, d *schema.ResourceData, m inte
 	for _, r := range res {
 		dsFlat, err := flattenDtcServer(r, connector)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten dtc server  : %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten dtc server  : %w", err))
 		}
 		results = append(results, dsFlat)
 	}
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
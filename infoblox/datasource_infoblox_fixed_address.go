@@ -160,14 +160,14 @@ func dataSourceFixedAddressRead(ctx context.Context, d *schema.ResourceData, m i
 	for _, r := range res {
 		fixedAddress, err := flattenFixedAddress(r)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten Fixed address : %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten Fixed address : %w", err))
 		}
 		results = append(results, fixedAddress)
 	}
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
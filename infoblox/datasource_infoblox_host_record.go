@@ -135,7 +135,7 @@ func dataSourceHostRecordRead(ctx context.Context, d *schema.ResourceData, m int
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.HostRecord{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting Host Record failed with filters %v: %s", filters, err.Error()))
+			return diagFromErr(fmt.Errorf("Getting Host Record failed with filters %v: %w", filters, err))
 		}
 	}
 
@@ -145,7 +145,7 @@ func dataSourceHostRecordRead(ctx context.Context, d *schema.ResourceData, m int
 	for _, r := range res {
 		recordaFlat, err := flattenRecordHost(r)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten Host Record: %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten Host Record: %w", err))
 		}
 
 		results = append(results, recordaFlat)
@@ -153,7 +153,7 @@ func dataSourceHostRecordRead(ctx context.Context, d *schema.ResourceData, m int
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
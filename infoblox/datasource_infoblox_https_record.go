@@ -0,0 +1,148 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceHTTPSRecord() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceHTTPSRecordRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of HTTPS-records matching filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS view which the record's zone belongs to.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The owner name of the HTTPS-record.",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The priority (0..65535) of the HTTPS-record.",
+						},
+						"target_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The target domain name of the HTTPS-record.",
+						},
+						"svc_params": dataSourceSvcParamsSchema(),
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone which the record belongs to.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TTL value for the HTTPS-record.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the HTTPS-record.",
+						},
+						"disable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines whether the HTTPS-record is disabled or not.",
+						},
+						"ext_attrs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Extensible attributes of the HTTPS-record, as a map in JSON format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceHTTPSRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	qp := ibclient.NewQueryParams(false, filters)
+
+	var res []ibclient.RecordHttps
+	if err := connector.GetObject(ibclient.NewEmptyHttpsRecord(), "", qp, &res); err != nil {
+		return diagFromErr(fmt.Errorf("failed to get HTTPS-records: %w", err))
+	}
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		rec, err := flattenRecordHTTPS(r)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("failed to flatten HTTPS-record: %w", err))
+		}
+		results = append(results, rec)
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diagFromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func flattenRecordHTTPS(rec ibclient.RecordHttps) (map[string]interface{}, error) {
+	var eaMap map[string]interface{}
+	if rec.Ea != nil && len(rec.Ea) > 0 {
+		eaMap = rec.Ea
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := int(rec.Ttl)
+	if !rec.UseTtl {
+		ttl = ttlUndef
+	}
+
+	return map[string]interface{}{
+		"id":          rec.Ref,
+		"dns_view":    rec.View,
+		"name":        rec.Name,
+		"priority":    int(rec.Priority),
+		"target_name": rec.TargetName,
+		"svc_params":  convertSvcParamsToInterface(rec.SvcParameters),
+		"zone":        rec.Zone,
+		"ttl":         ttl,
+		"comment":     rec.Comment,
+		"disable":     rec.Disable,
+		"ext_attrs":   string(ea),
+	}, nil
+}
@@ -163,14 +163,14 @@ func dataSourceRangeRead(ctx context.Context, d *schema.ResourceData, m interfac
 	for _, r := range res {
 		dtcPool, err := flattenNetworkRange(r)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten Network Range: %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten Network Range: %w", err))
 		}
 		results = append(results, dtcPool)
 	}
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
@@ -154,14 +154,14 @@ func dataSourceRangeTemplateRead(ctx context.Context, d *schema.ResourceData, m
 	for _, r := range res {
 		rangeTemplate, err := flattenRangeTemplate(r, connector)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten Range Template : %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten Range Template : %w", err))
 		}
 		results = append(results, rangeTemplate)
 	}
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
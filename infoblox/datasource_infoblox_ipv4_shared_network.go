@@ -128,14 +128,14 @@ func dataSourceIpv4SharedNetworkRead(ctx context.Context, d *schema.ResourceData
 	for _, r := range res {
 		record, err := flattenSharedNetwork(r)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten shared network: %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten shared network: %w", err))
 		}
 		results = append(results, record)
 	}
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
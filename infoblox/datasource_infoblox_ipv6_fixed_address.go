@@ -0,0 +1,202 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceIpv6FixedAddress() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIpv6FixedAddressRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of IPv6 fixed addresses matching filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the reservation: ADDRESS, PREFIX or BOTH.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Comment for the IPv6 fixed address; maximum 256 characters.",
+						},
+						"disable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines whether the IPv6 fixed address is disabled or not.",
+						},
+						"duid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The DHCPv6 Unique Identifier (DUID) of the client, the address is reserved for.",
+						},
+						"ext_attrs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Extensible attributes of the IPv6 fixed address, as a map in JSON format",
+						},
+						"ipv6addr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IPv6 address of the fixed address.",
+						},
+						"ipv6prefix": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IPv6 prefix of the fixed address.",
+						},
+						"ipv6prefix_bits": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The length of the IPv6 prefix of the fixed address.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the IPv6 fixed address.",
+						},
+						"network": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IPv6 network, in CIDR format, the fixed address belongs to.",
+						},
+						"network_view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the network view in which the IPv6 fixed address resides.",
+						},
+						"options": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "An array of DHCP option structs that lists the DHCP options associated with the object.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the DHCP option.",
+									},
+									"num": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The code of the DHCP option.",
+									},
+									"use_option": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Only applies to special options that are displayed separately from other options and have a use flag.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Value of the DHCP option",
+									},
+									"vendor_class": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the space this DHCP option is associated to.",
+									},
+								},
+							},
+						},
+						"use_options": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Use option is a flag that indicates whether the options field are used or not.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIpv6FixedAddressRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	qp := ibclient.NewQueryParams(false, filters)
+
+	var res []ibclient.Ipv6FixedAddress
+	if err := connector.GetObject(newEmptyIpv6FixedAddress(), "", qp, &res); err != nil {
+		return diagFromErr(fmt.Errorf("failed to get IPv6 fixed addresses: %w", err))
+	}
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		fixedAddress, err := flattenIpv6FixedAddress(r)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("failed to flatten IPv6 fixed address: %w", err))
+		}
+		results = append(results, fixedAddress)
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diagFromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func flattenIpv6FixedAddress(fixedAddress ibclient.Ipv6FixedAddress) (map[string]interface{}, error) {
+	var eaMap map[string]interface{}
+	if fixedAddress.Ea != nil && len(fixedAddress.Ea) > 0 {
+		eaMap = fixedAddress.Ea
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return nil, err
+	}
+	var ipv6PrefixBits int
+	if fixedAddress.Ipv6prefixBits != nil {
+		ipv6PrefixBits = int(*fixedAddress.Ipv6prefixBits)
+	}
+	res := map[string]interface{}{
+		"id":              fixedAddress.Ref,
+		"address_type":    fixedAddress.AddressType,
+		"comment":         stringPtrValue(fixedAddress.Comment),
+		"disable":         fixedAddress.Disable != nil && *fixedAddress.Disable,
+		"duid":            stringPtrValue(fixedAddress.Duid),
+		"ext_attrs":       string(ea),
+		"ipv6addr":        stringPtrValue(fixedAddress.Ipv6Addr),
+		"ipv6prefix":      stringPtrValue(fixedAddress.Ipv6prefix),
+		"ipv6prefix_bits": ipv6PrefixBits,
+		"name":            stringPtrValue(fixedAddress.Name),
+		"network":         stringPtrValue(fixedAddress.Network),
+		"network_view":    stringPtrValue(fixedAddress.NetworkView),
+		"use_options":     fixedAddress.UseOptions != nil && *fixedAddress.UseOptions,
+	}
+	if fixedAddress.Options != nil {
+		res["options"] = convertDhcpOptionsToInterface(fixedAddress.Options)
+	}
+
+	return res, nil
+}
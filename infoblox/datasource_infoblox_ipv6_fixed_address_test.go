@@ -0,0 +1,47 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var testAccDataSourceIpv6FixedAddress = `
+	resource "infoblox_ipv6_network" "net1" {
+		cidr = "2001:db8:16::/64"
+	}
+	resource "infoblox_ipv6_fixed_address" "fix1" {
+		ipv6addr = "2001:db8:16::5"
+		duid     = "00:01:00:01:2a:3b:4c:5d:11:22:33:44:55:77"
+		comment  = "IPv6 fixed address"
+		ext_attrs = jsonencode({
+			"Site" = "DC2"
+		})
+		depends_on = [infoblox_ipv6_network.net1]
+	}
+	data "infoblox_ipv6_fixed_address" "ds1" {
+		filters = {
+			"*Site" = "DC2"
+		}
+		depends_on = [infoblox_ipv6_fixed_address.fix1]
+	}`
+
+func TestAccDataSourceIpv6FixedAddress(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpv6FixedAddressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIpv6FixedAddress,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_fixed_address.ds1", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_fixed_address.ds1", "results.0.ipv6addr", "2001:db8:16::5"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_fixed_address.ds1", "results.0.duid", "00:01:00:01:2a:3b:4c:5d:11:22:33:44:55:77"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_fixed_address.ds1", "results.0.network", "2001:db8:16::/64"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_fixed_address.ds1", "results.0.comment", "IPv6 fixed address"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,232 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// dataSourceOptionFilterRulesSchema is the computed counterpart of optionFilterRulesSchema.
+func dataSourceOptionFilterRulesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The option filters applied to the range.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"filter": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the DHCP filter.",
+				},
+				"permission": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The permission to be applied: 'Allow' or 'Deny'.",
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIpv6Range() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIpv6RangeRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of IPv6 ranges matching filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the range: ADDRESS, PREFIX or BOTH.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Comment for the range; maximum 256 characters.",
+						},
+						"disable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines whether a range is disabled or not.",
+						},
+						"end_addr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IPv6 end address of the range.",
+						},
+						"exclude": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The ranges of IPv6 addresses, which the appliance does not assign to clients.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start_address": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IPv6 starting address of the exclusion range.",
+									},
+									"end_address": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IPv6 end address of the exclusion range.",
+									},
+									"comment": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Comment for the exclusion range.",
+									},
+								},
+							},
+						},
+						"ext_attrs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Extensible attributes of the range, as a map in JSON format",
+						},
+						"ipv6_end_prefix": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The end IPv6 prefix of the range.",
+						},
+						"ipv6_prefix_bits": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The length of the delegated prefixes.",
+						},
+						"ipv6_start_prefix": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The starting IPv6 prefix of the range.",
+						},
+						"member": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "The member that will provide service for this range.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the range.",
+						},
+						"network": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IPv6 network to which this range belongs, in CIDR format.",
+						},
+						"network_view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the network view in which this range resides.",
+						},
+						"option_filter_rules": dataSourceOptionFilterRulesSchema(),
+						"server_association_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of server that is going to serve the range.",
+						},
+						"start_addr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IPv6 starting address of the range.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIpv6RangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	qp := ibclient.NewQueryParams(false, filters)
+
+	var res []ibclient.IPv6Range
+	if err := connector.GetObject(newEmptyIpv6Range(), "", qp, &res); err != nil {
+		return diagFromErr(fmt.Errorf("failed to get IPv6 ranges: %w", err))
+	}
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		ipv6Range, err := flattenIpv6Range(r)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("failed to flatten IPv6 range: %w", err))
+		}
+		results = append(results, ipv6Range)
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diagFromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func flattenIpv6Range(ipv6Range ibclient.IPv6Range) (map[string]interface{}, error) {
+	var eaMap map[string]interface{}
+	if ipv6Range.Ea != nil && len(ipv6Range.Ea) > 0 {
+		eaMap = ipv6Range.Ea
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return nil, err
+	}
+	var prefixBits int
+	if ipv6Range.Ipv6PrefixBits != nil {
+		prefixBits = int(*ipv6Range.Ipv6PrefixBits)
+	}
+	res := map[string]interface{}{
+		"id":                      ipv6Range.Ref,
+		"address_type":            stringPtrValue(ipv6Range.AddressType),
+		"comment":                 stringPtrValue(ipv6Range.Comment),
+		"disable":                 ipv6Range.Disable != nil && *ipv6Range.Disable,
+		"end_addr":                stringPtrValue(ipv6Range.EndAddr),
+		"exclude":                 convertExclusionRangesToInterface(ipv6Range.Exclude),
+		"ext_attrs":               string(ea),
+		"ipv6_end_prefix":         stringPtrValue(ipv6Range.Ipv6EndPrefix),
+		"ipv6_prefix_bits":        prefixBits,
+		"ipv6_start_prefix":       stringPtrValue(ipv6Range.Ipv6StartPrefix),
+		"name":                    stringPtrValue(ipv6Range.Name),
+		"network":                 stringPtrValue(ipv6Range.Network),
+		"network_view":            stringPtrValue(ipv6Range.NetworkView),
+		"option_filter_rules":     convertFilterRulesToInterface(ipv6Range.OptionFilterRules),
+		"server_association_type": stringPtrValue(ipv6Range.ServerAssociationType),
+		"start_addr":              stringPtrValue(ipv6Range.StartAddr),
+	}
+	if ipv6Range.Member != nil {
+		res["member"] = convertDhcpMemberToMap(ipv6Range.Member)
+	}
+
+	return res, nil
+}
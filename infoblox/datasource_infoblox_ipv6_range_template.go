@@ -0,0 +1,151 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceIpv6RangeTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIpv6RangeTemplateRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of IPv6 range templates matching filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cloud_api_compatible": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "This flag controls whether this template can be used to create network objects in a cloud-computing deployment.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Comment for the IPv6 range template.",
+						},
+						"exclude": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The ranges of IPv6 addresses, which the appliance does not assign to clients, of a range created from the template.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"offset": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The address offset of the exclusion range.",
+									},
+									"number_of_addresses": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The number of addresses in the exclusion range.",
+									},
+									"comment": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Comment for the exclusion range.",
+									},
+								},
+							},
+						},
+						"member": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "The member that will provide service for a range, created from the template.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the IPv6 range template.",
+						},
+						"number_of_addresses": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of addresses of a range, created from the template.",
+						},
+						"offset": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The offset of the start address of a range, created from the template, in its network.",
+						},
+						"option_filter_rules": dataSourceOptionFilterRulesSchema(),
+						"server_association_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of server that is going to serve a range, created from the template.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIpv6RangeTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	qp := ibclient.NewQueryParams(false, filters)
+
+	var res []ibclient.Ipv6rangetemplate
+	if err := connector.GetObject(newEmptyIpv6RangeTemplate(), "", qp, &res); err != nil {
+		return diagFromErr(fmt.Errorf("failed to get IPv6 range templates: %w", err))
+	}
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		results = append(results, flattenIpv6RangeTemplate(r))
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diagFromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func flattenIpv6RangeTemplate(rangeTemplate ibclient.Ipv6rangetemplate) map[string]interface{} {
+	res := map[string]interface{}{
+		"id":                      rangeTemplate.Ref,
+		"cloud_api_compatible":    rangeTemplate.CloudApiCompatible != nil && *rangeTemplate.CloudApiCompatible,
+		"comment":                 stringPtrValue(rangeTemplate.Comment),
+		"exclude":                 convertExclusionRangeTemplatesToInterface(rangeTemplate.Exclude),
+		"name":                    stringPtrValue(rangeTemplate.Name),
+		"option_filter_rules":     convertFilterRulesToInterface(rangeTemplate.OptionFilterRules),
+		"server_association_type": rangeTemplate.ServerAssociationType,
+	}
+	if rangeTemplate.NumberOfAddresses != nil {
+		res["number_of_addresses"] = int(*rangeTemplate.NumberOfAddresses)
+	}
+	if rangeTemplate.Offset != nil {
+		res["offset"] = int(*rangeTemplate.Offset)
+	}
+	if rangeTemplate.Member != nil {
+		res["member"] = convertDhcpMemberToMap(rangeTemplate.Member)
+	}
+
+	return res
+}
@@ -0,0 +1,40 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var testAccDataSourceIpv6RangeTemplate = `
+	resource "infoblox_ipv6_range_template" "template1" {
+		name                = "ipv6-range-template-ds"
+		number_of_addresses = 64
+		offset              = 16
+		comment             = "IPv6 range template"
+	}
+	data "infoblox_ipv6_range_template" "ds1" {
+		filters = {
+			name = "ipv6-range-template-ds"
+		}
+		depends_on = [infoblox_ipv6_range_template.template1]
+	}`
+
+func TestAccDataSourceIpv6RangeTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpv6RangeTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIpv6RangeTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_range_template.ds1", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_range_template.ds1", "results.0.number_of_addresses", "64"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_range_template.ds1", "results.0.offset", "16"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_range_template.ds1", "results.0.comment", "IPv6 range template"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var testAccDataSourceIpv6Range = `
+	resource "infoblox_ipv6_network" "net1" {
+		cidr = "2001:db8:18::/64"
+	}
+	resource "infoblox_ipv6_range" "range1" {
+		network    = infoblox_ipv6_network.net1.cidr
+		start_addr = "2001:db8:18::100"
+		end_addr   = "2001:db8:18::1ff"
+		comment    = "IPv6 range"
+		ext_attrs = jsonencode({
+			"Site" = "DC3"
+		})
+	}
+	data "infoblox_ipv6_range" "ds1" {
+		filters = {
+			"*Site" = "DC3"
+		}
+		depends_on = [infoblox_ipv6_range.range1]
+	}`
+
+func TestAccDataSourceIpv6Range(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpv6RangeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIpv6Range,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_range.ds1", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_range.ds1", "results.0.start_addr", "2001:db8:18::100"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_range.ds1", "results.0.end_addr", "2001:db8:18::1ff"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_range.ds1", "results.0.network", "2001:db8:18::/64"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_range.ds1", "results.0.comment", "IPv6 range"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,174 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceIpv6SharedNetwork() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIpv6SharedNetworkRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of IPv6 shared networks matching filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the IPv6 shared network object.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The comment for the IPv6 shared network object.",
+						},
+						"ext_attrs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Extensible attributes of the IPv6 shared network, as a map in JSON format.",
+						},
+						"disable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "The disable flag for the IPv6 shared network object.",
+						},
+						"networks": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The CIDRs of the IPv6 networks belonging to the shared network.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"network_view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the network view in which this shared network resides.",
+						},
+						"use_options": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Use flag for options.",
+						},
+						"options": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "An array of DHCPv6 option structs that lists the DHCP options associated with the object.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the DHCP option.",
+									},
+									"num": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The code of the DHCP option.",
+									},
+									"use_option": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Only applies to special options that are displayed separately from other options and have a use flag.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Value of the DHCP option",
+									},
+									"vendor_class": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the space this DHCP option is associated to.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIpv6SharedNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	qp := ibclient.NewQueryParams(false, filters)
+
+	var res []ibclient.IPv6SharedNetwork
+	if err := connector.GetObject(newEmptyIpv6SharedNetwork(), "", qp, &res); err != nil {
+		return diagFromErr(fmt.Errorf("failed to get IPv6 shared networks: %w", err))
+	}
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		sharedNetwork, err := flattenIpv6SharedNetwork(r)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("failed to flatten IPv6 shared network: %w", err))
+		}
+		results = append(results, sharedNetwork)
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diagFromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func flattenIpv6SharedNetwork(sharedNetwork ibclient.IPv6SharedNetwork) (map[string]interface{}, error) {
+	var eaMap map[string]interface{}
+	if sharedNetwork.Ea != nil && len(sharedNetwork.Ea) > 0 {
+		eaMap = sharedNetwork.Ea
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return nil, err
+	}
+	networks := make([]interface{}, 0, len(sharedNetwork.Networks))
+	for _, network := range sharedNetwork.Networks {
+		networks = append(networks, ipv6NetworkCIDR(network.Ref))
+	}
+
+	res := map[string]interface{}{
+		"id":           sharedNetwork.Ref,
+		"name":         stringPtrValue(sharedNetwork.Name),
+		"comment":      stringPtrValue(sharedNetwork.Comment),
+		"ext_attrs":    string(ea),
+		"disable":      sharedNetwork.Disable != nil && *sharedNetwork.Disable,
+		"networks":     networks,
+		"network_view": sharedNetwork.NetworkView,
+		"use_options":  sharedNetwork.UseOptions != nil && *sharedNetwork.UseOptions,
+		"options":      convertDhcpOptionsToInterface(sharedNetwork.Options),
+	}
+
+	return res, nil
+}
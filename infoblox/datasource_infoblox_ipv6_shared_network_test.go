@@ -0,0 +1,45 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var testAccDataSourceIpv6SharedNetwork = `
+	resource "infoblox_ipv6_network" "net1" {
+		cidr = "2001:db8:1b::/64"
+	}
+	resource "infoblox_ipv6_shared_network" "shared1" {
+		name     = "ipv6-shared-network-ds"
+		networks = [infoblox_ipv6_network.net1.cidr]
+		comment  = "IPv6 shared network"
+		ext_attrs = jsonencode({
+			"Site" = "DC4"
+		})
+	}
+	data "infoblox_ipv6_shared_network" "ds1" {
+		filters = {
+			"*Site" = "DC4"
+		}
+		depends_on = [infoblox_ipv6_shared_network.shared1]
+	}`
+
+func TestAccDataSourceIpv6SharedNetwork(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpv6SharedNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIpv6SharedNetwork,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_shared_network.ds1", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_shared_network.ds1", "results.0.name", "ipv6-shared-network-ds"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_shared_network.ds1", "results.0.networks.0", "2001:db8:1b::/64"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_shared_network.ds1", "results.0.comment", "IPv6 shared network"),
+				),
+			},
+		},
+	})
+}
@@ -98,7 +98,7 @@ func dataSourceMXRecordRead(ctx context.Context, d *schema.ResourceData, m inter
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.RecordMX{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting MX Record failed with filters %v: %s", filters, err.Error()))
+			return diagFromErr(fmt.Errorf("Getting MX Record failed with filters %v: %w", filters, err))
 		}
 	}
 
@@ -108,7 +108,7 @@ func dataSourceMXRecordRead(ctx context.Context, d *schema.ResourceData, m inter
 	for _, mr := range res {
 		recordmxFlat, err := flattenRecordMX(mr)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten MX Record: %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten MX Record: %w", err))
 		}
 
 		results = append(results, recordmxFlat)
@@ -116,7 +116,7 @@ func dataSourceMXRecordRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
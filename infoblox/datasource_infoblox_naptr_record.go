@@ -0,0 +1,164 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceNAPTRRecord() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNAPTRRecordRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of NAPTR-records matching filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS view which the record's zone belongs to.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The domain name of the NAPTR-record.",
+						},
+						"order": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The order in which the NAPTR-records must be processed.",
+						},
+						"preference": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The preference of the NAPTR-records with the same order.",
+						},
+						"flags": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The flag which controls the rewriting and interpretation of the fields.",
+						},
+						"services": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The services and protocols, available at the domain name after the rewrite.",
+						},
+						"regexp": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The substitution expression, applied to the original string.",
+						},
+						"replacement": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The next domain name to look up.",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone which the record belongs to.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TTL value for the NAPTR-record.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the NAPTR-record.",
+						},
+						"ext_attrs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Extensible attributes of the NAPTR-record, as a map in JSON format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNAPTRRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	qp := ibclient.NewQueryParams(false, filters)
+
+	var res []ibclient.RecordNaptr
+	if err := connector.GetObject(newEmptyNaptrRecord(), "", qp, &res); err != nil {
+		return diagFromErr(fmt.Errorf("failed to get NAPTR-records: %w", err))
+	}
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		rec, err := flattenRecordNAPTR(r)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("failed to flatten NAPTR-record: %w", err))
+		}
+		results = append(results, rec)
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diagFromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func flattenRecordNAPTR(rec ibclient.RecordNaptr) (map[string]interface{}, error) {
+	var eaMap map[string]interface{}
+	if rec.Ea != nil && len(rec.Ea) > 0 {
+		eaMap = rec.Ea
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := ttlUndef
+	if rec.Ttl != nil && rec.UseTtl != nil && *rec.UseTtl {
+		ttl = int(*rec.Ttl)
+	}
+
+	return map[string]interface{}{
+		"id":          rec.Ref,
+		"name":        stringPtrValue(rec.Name),
+		"order":       uint32PtrValue(rec.Order),
+		"preference":  uint32PtrValue(rec.Preference),
+		"flags":       stringPtrValue(rec.Flags),
+		"services":    stringPtrValue(rec.Services),
+		"regexp":      stringPtrValue(rec.Regexp),
+		"replacement": stringPtrValue(rec.Replacement),
+		"dns_view":    rec.View,
+		"zone":        rec.Zone,
+		"ttl":         ttl,
+		"comment":     stringPtrValue(rec.Comment),
+		"ext_attrs":   string(ea),
+	}, nil
+}
@@ -0,0 +1,52 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var testAccDataSourceNAPTRRecord = `
+	resource "infoblox_zone_auth" "zone1" {
+		fqdn = "ds-naptr.test.com"
+	}
+	resource "infoblox_naptr_record" "rec1" {
+		name        = "ds-naptr.test.com"
+		order       = 10
+		preference  = 100
+		flags       = "S"
+		services    = "SIP+D2T"
+		replacement = "_sip._tcp.ds-naptr.test.com"
+		comment     = "SIP over TCP"
+		depends_on  = [infoblox_zone_auth.zone1]
+	}
+	data "infoblox_naptr_record" "ds1" {
+		filters = {
+			name = "ds-naptr.test.com"
+		}
+		depends_on = [infoblox_naptr_record.rec1]
+	}`
+
+func TestAccDataSourceNAPTRRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNAPTRRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNAPTRRecord,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.ds1", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.ds1", "results.0.name", "ds-naptr.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.ds1", "results.0.zone", "ds-naptr.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.ds1", "results.0.dns_view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.ds1", "results.0.order", "10"),
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.ds1", "results.0.preference", "100"),
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.ds1", "results.0.services", "SIP+D2T"),
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.ds1", "results.0.replacement", "_sip._tcp.ds-naptr.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.ds1", "results.0.comment", "SIP over TCP"),
+				),
+			},
+		},
+	})
+}
@@ -119,7 +119,7 @@ func dataSourceIPv4NetworkRead(ctx context.Context, d *schema.ResourceData, m in
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.Ipv4Network{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting IPv4 network failed with filters %v: %s", filters, err.Error()))
+			return diagFromErr(fmt.Errorf("Getting IPv4 network failed with filters %v: %w", filters, err))
 		}
 	}
 
@@ -129,7 +129,7 @@ func dataSourceIPv4NetworkRead(ctx context.Context, d *schema.ResourceData, m in
 	for _, n := range res {
 		networkFlat, err := flattenIpv4Network(n)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten network: %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten network: %w", err))
 		}
 
 		results = append(results, networkFlat)
@@ -137,7 +137,7 @@ func dataSourceIPv4NetworkRead(ctx context.Context, d *schema.ResourceData, m in
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
@@ -230,7 +230,7 @@ func dataSourceIPv6NetworkRead(ctx context.Context, d *schema.ResourceData, m in
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.Ipv6Network{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting IPv6 network failed with filters %v: %s", filters, err.Error()))
+			return diagFromErr(fmt.Errorf("Getting IPv6 network failed with filters %v: %w", filters, err))
 		}
 	}
 
@@ -240,7 +240,7 @@ func dataSourceIPv6NetworkRead(ctx context.Context, d *schema.ResourceData, m in
 	for _, n := range res {
 		networkFlat, err := flattenIpv6Network(n)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten network: %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten network: %w", err))
 		}
 
 		results = append(results, networkFlat)
@@ -248,7 +248,7 @@ func dataSourceIPv6NetworkRead(ctx context.Context, d *schema.ResourceData, m in
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
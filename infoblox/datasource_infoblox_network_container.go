@@ -76,7 +76,7 @@ func dataSourceIpv4NetworkContainerRead(ctx context.Context, d *schema.ResourceD
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.Ipv4NetworkContainer{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting IPv4 network container failed with filters %v: %s", filters, err.Error()))
+			return diagFromErr(fmt.Errorf("Getting IPv4 network container failed with filters %v: %w", filters, err))
 		}
 	}
 
@@ -86,7 +86,7 @@ func dataSourceIpv4NetworkContainerRead(ctx context.Context, d *schema.ResourceD
 	for _, nc := range res {
 		networkContainerFlat, err := flattenNetworkContainer(nc)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten network container: %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten network container: %w", err))
 		}
 
 		results = append(results, networkContainerFlat)
@@ -94,7 +94,7 @@ func dataSourceIpv4NetworkContainerRead(ctx context.Context, d *schema.ResourceD
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
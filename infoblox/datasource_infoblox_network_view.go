@@ -71,7 +71,7 @@ func dataSourceNetworkViewRead(ctx context.Context, d *schema.ResourceData, m in
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.NetworkView{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting network view failed with filters %v: %s", filters, err.Error()))
+			return diagFromErr(fmt.Errorf("Getting network view failed with filters %v: %w", filters, err))
 		}
 	}
 
@@ -81,7 +81,7 @@ func dataSourceNetworkViewRead(ctx context.Context, d *schema.ResourceData, m in
 	for _, n := range res {
 		networkViewFlat, err := flattenNetworkView(n)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten network view: %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten network view: %w", err))
 		}
 
 		results = append(results, networkViewFlat)
@@ -89,7 +89,7 @@ func dataSourceNetworkViewRead(ctx context.Context, d *schema.ResourceData, m in
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
@@ -0,0 +1,214 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceMemberServersSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the Grid member in FQDN format.",
+				},
+				"stealth": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Determines if the NS record of the member is hidden from DNS queries.",
+				},
+				"grid_replicate": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Determines if the member gets the zone data by the Grid replication.",
+				},
+				"lead": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Determines if the member is the lead secondary server.",
+				},
+			},
+		},
+	}
+}
+
+func dataSourceExternalServersSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the name server in FQDN format.",
+				},
+				"address": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The IPv4 or IPv6 address of the name server.",
+				},
+				"stealth": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Determines if the NS record of the name server is hidden from DNS queries.",
+				},
+				"tsig_key_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the TSIG key.",
+				},
+				"tsig_key_alg": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The algorithm of the TSIG key.",
+				},
+			},
+		},
+	}
+}
+
+// convertExternalServersToDataSource returns the external name servers without the secrets of their TSIG keys.
+func convertExternalServersToDataSource(servers []ibclient.NameServer) []map[string]interface{} {
+	res := convertExternalServersToInterface(servers)
+	for _, s := range res {
+		delete(s, "tsig_key")
+	}
+
+	return res
+}
+
+func dataSourceNsGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNsGroupRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of name server groups matching filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the name server group.",
+						},
+						"grid_primary": dataSourceMemberServersSchema(
+							"The Grid members, which are the primary servers of the group's zones."),
+						"grid_secondaries": dataSourceMemberServersSchema(
+							"The Grid members, which are the secondary servers of the group's zones."),
+						"external_primaries": dataSourceExternalServersSchema(
+							"The name servers outside of the Grid, which are the primary servers of the group's zones."),
+						"external_secondaries": dataSourceExternalServersSchema(
+							"The name servers outside of the Grid, which are the secondary servers of the group's zones."),
+						"is_grid_default": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines if the group is the default name server group of the Grid.",
+						},
+						"is_multimaster": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines if the group has more than one primary server.",
+						},
+						"use_external_primary": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines if the group uses the external primary servers.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A descriptive comment.",
+						},
+						"ext_attrs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Extensible attributes of the name server group, as a map in JSON format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	qp := ibclient.NewQueryParams(false, filters)
+
+	var res []ibclient.Nsgroup
+	if err := connector.GetObject(newEmptyNsGroup(), "", qp, &res); err != nil {
+		return diagFromErr(fmt.Errorf("failed to get name server groups: %w", err))
+	}
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		nsg, err := flattenNsGroup(r)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("failed to flatten name server group: %w", err))
+		}
+		results = append(results, nsg)
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diagFromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func flattenNsGroup(nsg ibclient.Nsgroup) (map[string]interface{}, error) {
+	var eaMap map[string]interface{}
+	if nsg.Ea != nil && len(nsg.Ea) > 0 {
+		eaMap = nsg.Ea
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"id":                   nsg.Ref,
+		"name":                 stringPtrValue(nsg.Name),
+		"grid_primary":         convertMemberServersToInterface(nsg.GridPrimary),
+		"grid_secondaries":     convertMemberServersToInterface(nsg.GridSecondaries),
+		"external_primaries":   convertExternalServersToDataSource(nsg.ExternalPrimaries),
+		"external_secondaries": convertExternalServersToDataSource(nsg.ExternalSecondaries),
+		"is_grid_default":      nsg.IsGridDefault != nil && *nsg.IsGridDefault,
+		"is_multimaster":       nsg.IsMultimaster,
+		"use_external_primary": nsg.UseExternalPrimary != nil && *nsg.UseExternalPrimary,
+		"comment":              stringPtrValue(nsg.Comment),
+		"ext_attrs":            string(ea),
+	}, nil
+}
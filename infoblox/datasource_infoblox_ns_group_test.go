@@ -0,0 +1,47 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var testAccDataSourceNsGroup = `
+	resource "infoblox_ns_group" "nsg1" {
+		name = "acc-test-ds-nsg"
+		grid_primary {
+			name = "infoblox.localdomain"
+		}
+		external_secondaries {
+			name    = "ns2.partner.com"
+			address = "10.0.0.2"
+		}
+		comment = "acceptance test group"
+	}
+	data "infoblox_ns_group" "ds1" {
+		filters = {
+			name = "acc-test-ds-nsg"
+		}
+		depends_on = [infoblox_ns_group.nsg1]
+	}`
+
+func TestAccDataSourceNsGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNsGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNsGroup,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_ns_group.ds1", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_ns_group.ds1", "results.0.name", "acc-test-ds-nsg"),
+					resource.TestCheckResourceAttr("data.infoblox_ns_group.ds1", "results.0.grid_primary.0.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr("data.infoblox_ns_group.ds1", "results.0.external_secondaries.0.address", "10.0.0.2"),
+					resource.TestCheckResourceAttr("data.infoblox_ns_group.ds1", "results.0.use_external_primary", "false"),
+					resource.TestCheckResourceAttr("data.infoblox_ns_group.ds1", "results.0.comment", "acceptance test group"),
+				),
+			},
+		},
+	})
+}
@@ -117,7 +117,7 @@ func dataSourceNSRecordRead(ctx context.Context, d *schema.ResourceData, m inter
 	for _, r := range res {
 		recordaFlat, err := flattenRecordNS(r)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten NS Record  : %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten NS Record  : %w", err))
 		}
 
 		results = append(results, recordaFlat)
@@ -125,7 +125,7 @@ func dataSourceNSRecordRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
@@ -100,7 +100,7 @@ func dataSourcePtrRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.RecordPTR{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting PTR Record failed with filters %v: %s", filters, err.Error()))
+			return diagFromErr(fmt.Errorf("Getting PTR Record failed with filters %v: %w", filters, err))
 		}
 	}
 
@@ -110,7 +110,7 @@ func dataSourcePtrRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 	for _, pt := range res {
 		recordptrFlat, err := flattenRecordPTR(pt)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten PTR Record: %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten PTR Record: %w", err))
 		}
 
 		results = append(results, recordptrFlat)
@@ -118,7 +118,7 @@ func dataSourcePtrRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
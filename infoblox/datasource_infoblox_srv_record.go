@@ -109,7 +109,7 @@ func dataSourceSRVRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.RecordSRV{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting SRV Record failed with filters %v: %s", filters, err.Error()))
+			return diagFromErr(fmt.Errorf("Getting SRV Record failed with filters %v: %w", filters, err))
 		}
 	}
 
@@ -119,7 +119,7 @@ func dataSourceSRVRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 	for _, sv := range res {
 		recordsrvFlat, err := flattenRecordSRV(sv)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten SRV Record: %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten SRV Record: %w", err))
 		}
 
 		results = append(results, recordsrvFlat)
@@ -127,7 +127,7 @@ func dataSourceSRVRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
@@ -0,0 +1,195 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// dataSourceSvcParamsSchema is the computed counterpart of svcParamsSchema.
+func dataSourceSvcParamsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The service parameters of the record.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"alpn": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The Application-Layer Protocol Negotiation (ALPN) protocol identifiers, supported by the service.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"port": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The TCP or UDP port of the service.",
+				},
+				"ipv4hint": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The IPv4 addresses, the clients may use to reach the service.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"ipv6hint": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The IPv6 addresses, the clients may use to reach the service.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"ech": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Encrypted ClientHello (ECH) configuration list, base64-encoded.",
+				},
+				"mandatory": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The keys of the service parameters, which are mandatory for the clients to support.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSVCBRecord() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSVCBRecordRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of SVCB-records matching filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS view which the record's zone belongs to.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The owner name of the SVCB-record.",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The priority (0..65535) of the SVCB-record.",
+						},
+						"target_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The target domain name of the SVCB-record.",
+						},
+						"svc_params": dataSourceSvcParamsSchema(),
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone which the record belongs to.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TTL value for the SVCB-record.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the SVCB-record.",
+						},
+						"disable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines whether the SVCB-record is disabled or not.",
+						},
+						"ext_attrs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Extensible attributes of the SVCB-record, as a map in JSON format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSVCBRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	qp := ibclient.NewQueryParams(false, filters)
+
+	var res []ibclient.RecordSVCB
+	if err := connector.GetObject(ibclient.NewEmptyRecordSVCB(), "", qp, &res); err != nil {
+		return diagFromErr(fmt.Errorf("failed to get SVCB-records: %w", err))
+	}
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		rec, err := flattenRecordSVCB(r)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("failed to flatten SVCB-record: %w", err))
+		}
+		results = append(results, rec)
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diagFromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func flattenRecordSVCB(rec ibclient.RecordSVCB) (map[string]interface{}, error) {
+	var eaMap map[string]interface{}
+	if rec.Ea != nil && len(rec.Ea) > 0 {
+		eaMap = rec.Ea
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := int(rec.Ttl)
+	if !rec.UseTtl {
+		ttl = ttlUndef
+	}
+
+	return map[string]interface{}{
+		"id":          rec.Ref,
+		"dns_view":    rec.View,
+		"name":        rec.Name,
+		"priority":    int(rec.Priority),
+		"target_name": rec.TargetName,
+		"svc_params":  convertSvcParamsToInterface(rec.SvcParameters),
+		"zone":        rec.Zone,
+		"ttl":         ttl,
+		"comment":     rec.Comment,
+		"disable":     rec.Disable,
+		"ext_attrs":   string(ea),
+	}, nil
+}
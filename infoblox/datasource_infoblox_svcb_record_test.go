@@ -0,0 +1,50 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var testAccDataSourceSVCBRecord = `
+	resource "infoblox_zone_auth" "zone1" {
+		fqdn = "ds-svcb.test.com"
+	}
+	resource "infoblox_svcb_record" "rec1" {
+		name        = "svc.ds-svcb.test.com"
+		priority    = 1
+		target_name = "backend.ds-svcb.test.com"
+		svc_params {
+			alpn = ["h2"]
+			port = 443
+		}
+		ext_attrs = jsonencode({
+			"Site" = "DC2"
+		})
+		depends_on = [infoblox_zone_auth.zone1]
+	}
+	data "infoblox_svcb_record" "ds1" {
+		filters = {
+			name = "svc.ds-svcb.test.com"
+		}
+		depends_on = [infoblox_svcb_record.rec1]
+	}`
+
+func TestAccDataSourceSVCBRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSVCBRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSVCBRecord,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_svcb_record.ds1", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_svcb_record.ds1", "results.0.target_name", "backend.ds-svcb.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_svcb_record.ds1", "results.0.zone", "ds-svcb.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_svcb_record.ds1", "results.0.svc_params.0.port", "443"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,158 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceTLSARecord() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTLSARecordRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of TLSA-records matching filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS view which the record's zone belongs to.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The owner name of the TLSA-record.",
+						},
+						"certificate_usage": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The usage of the certificate.",
+						},
+						"selector": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The part of the certificate to match.",
+						},
+						"matched_type": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The presentation of the certificate data.",
+						},
+						"certificate_data": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The certificate data to match, hex-encoded.",
+						},
+						"disable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines whether the TLSA-record is disabled or not.",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone which the record belongs to.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TTL value for the TLSA-record.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the TLSA-record.",
+						},
+						"ext_attrs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Extensible attributes of the TLSA-record, as a map in JSON format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTLSARecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	qp := ibclient.NewQueryParams(false, filters)
+
+	var res []ibclient.RecordTlsa
+	if err := connector.GetObject(newEmptyTlsaRecord(), "", qp, &res); err != nil {
+		return diagFromErr(fmt.Errorf("failed to get TLSA-records: %w", err))
+	}
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		rec, err := flattenRecordTLSA(r)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("failed to flatten TLSA-record: %w", err))
+		}
+		results = append(results, rec)
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diagFromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func flattenRecordTLSA(rec ibclient.RecordTlsa) (map[string]interface{}, error) {
+	var eaMap map[string]interface{}
+	if rec.Ea != nil && len(rec.Ea) > 0 {
+		eaMap = rec.Ea
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := ttlUndef
+	if rec.Ttl != nil && rec.UseTtl != nil && *rec.UseTtl {
+		ttl = int(*rec.Ttl)
+	}
+
+	return map[string]interface{}{
+		"id":                rec.Ref,
+		"name":              stringPtrValue(rec.Name),
+		"certificate_usage": uint32PtrValue(rec.CertificateUsage),
+		"selector":          uint32PtrValue(rec.Selector),
+		"matched_type":      uint32PtrValue(rec.MatchedType),
+		"certificate_data":  stringPtrValue(rec.CertificateData),
+		"disable":           rec.Disable != nil && *rec.Disable,
+		"dns_view":          stringPtrValue(rec.View),
+		"zone":              rec.Zone,
+		"ttl":               ttl,
+		"comment":           stringPtrValue(rec.Comment),
+		"ext_attrs":         string(ea),
+	}, nil
+}
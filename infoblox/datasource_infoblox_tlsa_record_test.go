@@ -0,0 +1,48 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var testAccDataSourceTLSARecord = `
+	resource "infoblox_zone_auth" "zone1" {
+		fqdn = "ds-tlsa.test.com"
+	}
+	resource "infoblox_tlsa_record" "rec1" {
+		name              = "_443._tcp.www.ds-tlsa.test.com"
+		certificate_usage = 3
+		selector          = 1
+		matched_type      = 1
+		certificate_data  = "0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6"
+		depends_on        = [infoblox_zone_auth.zone1]
+	}
+	data "infoblox_tlsa_record" "ds1" {
+		filters = {
+			name = "_443._tcp.www.ds-tlsa.test.com"
+		}
+		depends_on = [infoblox_tlsa_record.rec1]
+	}`
+
+func TestAccDataSourceTLSARecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTLSARecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTLSARecord,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_tlsa_record.ds1", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_tlsa_record.ds1", "results.0.name", "_443._tcp.www.ds-tlsa.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_tlsa_record.ds1", "results.0.zone", "ds-tlsa.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_tlsa_record.ds1", "results.0.dns_view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_tlsa_record.ds1", "results.0.certificate_usage", "3"),
+					resource.TestCheckResourceAttr("data.infoblox_tlsa_record.ds1", "results.0.matched_type", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_tlsa_record.ds1", "results.0.certificate_data", "0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6"),
+				),
+			},
+		},
+	})
+}
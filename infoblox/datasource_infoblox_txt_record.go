@@ -93,7 +93,7 @@ func dataSourceTXTRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.RecordTXT{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting TXT Record failed with filters %v: %s", filters, err.Error()))
+			return diagFromErr(fmt.Errorf("Getting TXT Record failed with filters %v: %w", filters, err))
 		}
 	}
 
@@ -103,7 +103,7 @@ func dataSourceTXTRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 	for _, tx := range res {
 		recordtxtFlat, err := flattenRecordTXT(tx)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten TXT Record: %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten TXT Record: %w", err))
 		}
 
 		results = append(results, recordtxtFlat)
@@ -111,7 +111,7 @@ func dataSourceTXTRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
@@ -48,6 +48,14 @@ func dataSourceZoneAuth() *schema.Resource {
 							Optional:    true,
 							Description: "The name server group that serves DNS for this zone.",
 						},
+						"grid_primary": dataSourceMemberServersSchema(
+							"The Grid members, which are the primary servers of the zone."),
+						"grid_secondaries": dataSourceMemberServersSchema(
+							"The Grid members, which are the secondary servers of the zone."),
+						"external_primaries": dataSourceExternalServersSchema(
+							"The name servers outside of the Grid, which are the primary servers of the zone."),
+						"external_secondaries": dataSourceExternalServersSchema(
+							"The name servers outside of the Grid, which are the secondary servers of the zone."),
 						"zone_format": {
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -76,7 +84,8 @@ func dataSourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m inter
 	var diags diag.Diagnostics
 
 	n := &ibclient.ZoneAuth{}
-	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "comment", "zone_format", "ns_group"))
+	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "comment", "zone_format", "ns_group",
+		"grid_primary", "grid_secondaries", "external_primaries", "external_secondaries"))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
 	qp := ibclient.NewQueryParams(false, filters)
@@ -89,7 +98,7 @@ func dataSourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m inter
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.ZoneAuth{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting Zone Auth failed with filters %v: %s", filters, err.Error()))
+			return diagFromErr(fmt.Errorf("Getting Zone Auth failed with filters %v: %w", filters, err))
 		}
 	}
 
@@ -99,7 +108,7 @@ func dataSourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m inter
 	for _, za := range res {
 		zoneauthFlat, err := flattenZoneAuth(za)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten Zone Auth : %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten Zone Auth : %w", err))
 		}
 
 		results = append(results, zoneauthFlat)
@@ -107,7 +116,7 @@ func dataSourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
@@ -134,6 +143,11 @@ func flattenZoneAuth(zoneauth ibclient.ZoneAuth) (map[string]interface{}, error)
 		"zone_format": zoneauth.ZoneFormat,
 		"fqdn":        zoneauth.Fqdn,
 		"ext_attrs":   string(ea),
+
+		"grid_primary":         convertMemberServersToInterface(zoneauth.GridPrimary),
+		"grid_secondaries":     convertMemberServersToInterface(zoneauth.GridSecondaries),
+		"external_primaries":   convertExternalServersToDataSource(zoneauth.ExternalPrimaries),
+		"external_secondaries": convertExternalServersToDataSource(zoneauth.ExternalSecondaries),
 	}
 
 	if zoneauth.View != nil {
@@ -27,6 +27,10 @@ func TestAccDataSourceZoneAuth(t *testing.T) {
 					resource.TestCheckResourceAttr("data.infoblox_zone_auth.acctest", "results.0.fqdn", "10.1.0.0/24"),
 					resource.TestCheckResourceAttr("data.infoblox_zone_auth.acctest", "results.0.zone_format", "IPV4"),
 					resource.TestCheckResourceAttr("data.infoblox_zone_auth.acctest", "results.0.comment", "test ipv4 reverse mapping zone"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_auth.acctest", "results.0.grid_primary.0.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_auth.acctest", "results.0.external_secondaries.0.address", "10.1.0.2"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_auth.acctest", "results.0.external_secondaries.0.tsig_key_name", "test-key"),
+					resource.TestCheckNoResourceAttr("data.infoblox_zone_auth.acctest", "results.0.external_secondaries.0.tsig_key"),
 				),
 			},
 		},
@@ -68,6 +72,15 @@ resource "infoblox_zone_auth" "foo1"{
 	soa_refresh = 1705
 	soa_retry = 850
 	comment = "test ipv4 reverse mapping zone"
+	grid_primary {
+		name = "infoblox.localdomain"
+	}
+	external_secondaries {
+		name = "ns2.test.com"
+		address = "10.1.0.2"
+		tsig_key_name = "test-key"
+		tsig_key = "c2VjcmV0LWtleQ=="
+	}
 	ext_attrs = jsonencode({
 		Site = "Test MapReverse"
 	})
@@ -124,7 +124,7 @@ func dataSourceZoneDelegatedRead(_ context.Context, d *schema.ResourceData, m in
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.ZoneDelegated{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting Record failed with filters %v: %s", filters, err.Error()))
+			return diagFromErr(fmt.Errorf("Getting Record failed with filters %v: %w", filters, err))
 		}
 	}
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -133,14 +133,14 @@ func dataSourceZoneDelegatedRead(_ context.Context, d *schema.ResourceData, m in
 	for _, r := range res {
 		zoneDelegatedFlat, err := flattenZoneDelegated(r)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten zone delegated  : %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten zone delegated  : %w", err))
 		}
 		results = append(results, zoneDelegatedFlat)
 	}
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
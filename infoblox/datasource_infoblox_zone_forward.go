@@ -166,7 +166,7 @@ func dataSourceZoneForwardRead(_ context.Context, d *schema.ResourceData, m inte
 			// For data sources, empty results are valid - just return empty results
 			res = []ibclient.ZoneForward{}
 		} else {
-			return diag.FromErr(fmt.Errorf("Getting Zone Forward failed with filters %v: %s", filters, err))
+			return diagFromErr(fmt.Errorf("Getting Zone Forward failed with filters %v: %w", filters, err))
 		}
 	}
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -175,14 +175,14 @@ func dataSourceZoneForwardRead(_ context.Context, d *schema.ResourceData, m inte
 	for _, r := range res {
 		zfFlat, err := flattenZoneForward(r)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to flatten zone forward  : %w", err))
+			return diagFromErr(fmt.Errorf("failed to flatten zone forward  : %w", err))
 		}
 		results = append(results, zfFlat)
 	}
 
 	err = d.Set("results", results)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// always run
@@ -0,0 +1,194 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceZoneStub() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceZoneStubRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of Stub Zones matching filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of this DNS zone.",
+						},
+						"view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The DNS view in which the zone resides.",
+						},
+						"zone_format": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The format of the zone.",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The RFC2317 prefix of an IPv4 reverse zone.",
+						},
+						"stub_from": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The primary servers (masters) of this stub zone.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IP address of the primary server.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the primary server.",
+									},
+								},
+							},
+						},
+						"external_ns_group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A forward stub server name server group.",
+						},
+						"stub_members": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The Grid member servers of this stub zone.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the Grid member in FQDN format.",
+									},
+								},
+							},
+						},
+						"ns_group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A stub member name server group.",
+						},
+						"disable_forwarding": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines if the name servers of the zone should not forward the zone's queries to the configured forwarders.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A descriptive comment.",
+						},
+						"disable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines if the zone is disabled or not.",
+						},
+						"locked": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines if the zone is locked or not.",
+						},
+						"parent": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The parent zone of this zone.",
+						},
+						"ext_attrs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Extensible attributes of the zone stub, as a map in JSON format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceZoneStubRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	qp := ibclient.NewQueryParams(false, filters)
+
+	var res []ibclient.ZoneStub
+	if err := connector.GetObject(newEmptyZoneStub(), "", qp, &res); err != nil {
+		return diagFromErr(fmt.Errorf("failed to get zone stubs: %w", err))
+	}
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		zs, err := flattenZoneStub(r)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("failed to flatten zone stub: %w", err))
+		}
+		results = append(results, zs)
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diagFromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func flattenZoneStub(zs ibclient.ZoneStub) (map[string]interface{}, error) {
+	var eaMap map[string]interface{}
+	if zs.Ea != nil && len(zs.Ea) > 0 {
+		eaMap = zs.Ea
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"id":                 zs.Ref,
+		"fqdn":               zs.Fqdn,
+		"view":               stringPtrValue(zs.View),
+		"zone_format":        zs.ZoneFormat,
+		"prefix":             stringPtrValue(zs.Prefix),
+		"stub_from":          convertNullableNameServersToInterface(ibclient.NullableNameServers{NameServers: zs.StubFrom}),
+		"external_ns_group":  stringPtrValue(zs.ExternalNsGroup),
+		"stub_members":       convertStubMembersToInterface(zs.StubMembers),
+		"ns_group":           stringPtrValue(zs.NsGroup),
+		"disable_forwarding": zs.DisableForwarding != nil && *zs.DisableForwarding,
+		"comment":            stringPtrValue(zs.Comment),
+		"disable":            zs.Disable != nil && *zs.Disable,
+		"locked":             zs.Locked != nil && *zs.Locked,
+		"parent":             zs.Parent,
+		"ext_attrs":          string(ea),
+	}, nil
+}
@@ -0,0 +1,47 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var testAccDataSourceZoneStub = `
+	resource "infoblox_zone_stub" "zone1" {
+		fqdn = "ds.stub.test.com"
+		stub_from {
+			name    = "ns1.partner.com"
+			address = "10.0.0.1"
+		}
+		stub_members {
+			name = "infoblox.localdomain"
+		}
+		comment = "partner stub zone"
+	}
+	data "infoblox_zone_stub" "ds1" {
+		filters = {
+			fqdn = "ds.stub.test.com"
+		}
+		depends_on = [infoblox_zone_stub.zone1]
+	}`
+
+func TestAccDataSourceZoneStub(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneStubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceZoneStub,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_zone_stub.ds1", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_stub.ds1", "results.0.fqdn", "ds.stub.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_stub.ds1", "results.0.view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_stub.ds1", "results.0.stub_from.0.address", "10.0.0.1"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_stub.ds1", "results.0.stub_members.0.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_stub.ds1", "results.0.comment", "partner stub zone"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,314 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/mail"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// eaDefinitionCache keeps the definitions of extensible attributes, loaded from NIOS once per provider instance.
+type eaDefinitionCache struct {
+	mu   sync.Mutex
+	defs map[string]*ibclient.EADefinition
+}
+
+// get returns the definitions of extensible attributes by their names, loading them with the given connector
+// on the first call. A failed load is not cached, so it is attempted again on the next call.
+func (c *eaDefinitionCache) get(conn ibclient.IBConnector) (map[string]*ibclient.EADefinition, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.defs != nil {
+		return c.defs, nil
+	}
+	defs, err := loadEADefinitions(conn)
+	if err != nil {
+		return nil, err
+	}
+	c.defs = defs
+
+	return defs, nil
+}
+
+func loadEADefinitions(conn ibclient.IBConnector) (map[string]*ibclient.EADefinition, error) {
+	eadef := ibclient.NewEADefinition(ibclient.EADefinition{})
+	eadef.SetReturnFields([]string{"name", "type", "flags", "list_values", "min", "max", "allowed_object_types"})
+
+	var res []ibclient.EADefinition
+	if err := conn.GetObject(eadef, "", ibclient.NewQueryParams(false, nil), &res); err != nil && !isNotFoundError(err) {
+		return nil, fmt.Errorf("failed to get extensible attribute definitions: %w", err)
+	}
+
+	defs := make(map[string]*ibclient.EADefinition, len(res))
+	for i := range res {
+		if res[i].Name != nil {
+			defs[*res[i].Name] = &res[i]
+		}
+	}
+
+	return defs, nil
+}
+
+// eaDefinitions returns the definitions of extensible attributes, cached by the provider's meta object if any.
+func eaDefinitions(conn ibclient.IBConnector) (map[string]*ibclient.EADefinition, error) {
+	if conn == nil {
+		return nil, fmt.Errorf("no connection to NIOS")
+	}
+	if cache := providerSettings(conn).eaDefs; cache != nil {
+		return cache.get(conn)
+	}
+
+	return loadEADefinitions(conn)
+}
+
+func hasEAFlag(eadef *ibclient.EADefinition, flag string) bool {
+	return eadef.Flags != nil && strings.Contains(*eadef.Flags, flag)
+}
+
+// eaAppliesTo checks whether the extensible attribute may be set on the given object types.
+// The types are compared regardless of case and punctuation, ex. 'record:a' matches 'RecordA'.
+func eaAppliesTo(eadef *ibclient.EADefinition, objectTypes []string) bool {
+	if len(eadef.AllowedObjectTypes) == 0 {
+		return true
+	}
+
+	normalize := func(s string) string {
+		return strings.Map(func(r rune) rune {
+			if r == ':' || r == '_' || r == '-' || r == ' ' {
+				return -1
+			}
+			return r
+		}, strings.ToLower(s))
+	}
+	for _, allowed := range eadef.AllowedObjectTypes {
+		for _, objType := range objectTypes {
+			if normalize(allowed) == normalize(objType) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// validateEAValue checks a value of an extensible attribute, as it comes from a JSON-formatted 'ext_attrs' field
+// or an 'extensible_attributes' block, against the attribute's definition.
+func validateEAValue(eadef *ibclient.EADefinition, val interface{}) error {
+	if list, isList := val.([]interface{}); isList {
+		if !hasEAFlag(eadef, "V") {
+			return fmt.Errorf("multiple values are not allowed")
+		}
+		for _, item := range list {
+			if err := validateEAValue(eadef, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch eadef.Type {
+	case "INTEGER":
+		var num float64
+		switch v := val.(type) {
+		case float64:
+			num = v
+		case int:
+			num = float64(v)
+		case string:
+			parsed, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return fmt.Errorf("'%s' is not an integer", v)
+			}
+			num = float64(parsed)
+		default:
+			return fmt.Errorf("'%v' is not an integer", val)
+		}
+		if num != math.Trunc(num) {
+			return fmt.Errorf("'%v' is not an integer", val)
+		}
+		if eadef.Min != nil && num < float64(*eadef.Min) {
+			return fmt.Errorf("%v is less than the minimum value %d", val, *eadef.Min)
+		}
+		if eadef.Max != nil && num > float64(*eadef.Max) {
+			return fmt.Errorf("%v is greater than the maximum value %d", val, *eadef.Max)
+		}
+	case "DATE":
+		switch v := val.(type) {
+		case float64:
+			// The number of seconds since the epoch.
+		case string:
+			if !isEADate(v) {
+				return fmt.Errorf("'%s' is not a date, expected ex. '2024-01-31' or '2024-01-31T10:00:00Z'", v)
+			}
+		default:
+			return fmt.Errorf("'%v' is not a date", val)
+		}
+	case "EMAIL":
+		v, ok := val.(string)
+		if !ok {
+			return fmt.Errorf("'%v' is not an email address", val)
+		}
+		if addr, err := mail.ParseAddress(v); err != nil || addr.Address != v {
+			return fmt.Errorf("'%s' is not an email address", v)
+		}
+	case "ENUM":
+		v := fmt.Sprint(val)
+		values := make([]string, 0, len(eadef.ListValues))
+		for _, listVal := range eadef.ListValues {
+			if listVal == nil {
+				continue
+			}
+			if listVal.Value == v {
+				return nil
+			}
+			values = append(values, listVal.Value)
+		}
+		return fmt.Errorf("'%s' is not one of the allowed values: '%s'", v, strings.Join(values, "', '"))
+	}
+
+	return nil
+}
+
+func isEADate(val string) bool {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"} {
+		if _, err := time.Parse(layout, val); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkEAsAgainstDefinitions checks extensible attributes to be set on an object of the given types
+// against their definitions: all of them must be defined, their values must match the definitions,
+// and, for a new object, mandatory ones must be present, unless the object inherits them from its parent.
+func checkEAsAgainstDefinitions(
+	extAttrs map[string]interface{},
+	defs map[string]*ibclient.EADefinition,
+	objectTypes []string,
+	isNewObject bool,
+	inheritsEAs bool) error {
+
+	var errs []string
+
+	names := make([]string, 0, len(extAttrs))
+	for name := range extAttrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		eadef, found := defs[name]
+		if !found {
+			errs = append(errs, fmt.Sprintf("extensible attribute '%s' is not defined on NIOS", name))
+			continue
+		}
+		if err := validateEAValue(eadef, extAttrs[name]); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid value of %s extensible attribute '%s': %s", eadef.Type, name, err))
+		}
+	}
+
+	// Mandatory EAs of an existing object are already set, and are kept on update unless changed.
+	var missing []string
+	for name, eadef := range defs {
+		if !isNewObject {
+			break
+		}
+		if _, found := extAttrs[name]; found || !hasEAFlag(eadef, "M") || !eaAppliesTo(eadef, objectTypes) {
+			continue
+		}
+		if inheritsEAs && hasEAFlag(eadef, "I") {
+			continue
+		}
+		missing = append(missing, name)
+	}
+	sort.Strings(missing)
+	for _, name := range missing {
+		errs = append(errs, fmt.Sprintf("mandatory extensible attribute '%s' is not set", name))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// validateExtAttrsDiff returns a CustomizeDiff function, which checks the 'ext_attrs' field and the 'extensible_attributes' blocks of a resource
+// managing NIOS objects of the given types against the definitions of extensible attributes, at plan time.
+// inheritable is set for the resources with the 'inherit_ext_attrs' field.
+func validateExtAttrsDiff(objectTypes []string, inheritable bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		isNewObject := d.Id() == ""
+		if !d.NewValueKnown("ext_attrs") || !d.NewValueKnown("extensible_attributes") {
+			return nil
+		}
+		if !isNewObject && !d.HasChanges("ext_attrs", "extensible_attributes") {
+			return nil
+		}
+		extAttrs, err := terraformExtAttrs(d, m)
+		if err != nil {
+			return err
+		}
+		extAttrs = withProviderEAs(extAttrs, m)
+
+		conn, ok := m.(ibclient.IBConnector)
+		if !ok {
+			return nil
+		}
+		defs, err := eaDefinitions(conn)
+		if err != nil {
+			// The definitions may not be readable with the user's permissions; NIOS checks the EAs anyway.
+			log.Warn(ctx, "skipping validation of extensible attributes", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return nil
+		}
+
+		// EAs ignored by the provider are managed on the NIOS side, even mandatory ones.
+		managedDefs := make(map[string]*ibclient.EADefinition, len(defs))
+		for name, eadef := range defs {
+			if !isIgnoredEA(name, m) {
+				managedDefs[name] = eadef
+			}
+		}
+
+		// Inheritable mandatory EAs may be supplied by the parent object.
+		inheritsEAs := inheritable && d.Get("inherit_ext_attrs").(bool)
+		if err = checkEAsAgainstDefinitions(extAttrs, managedDefs, objectTypes, isNewObject, inheritsEAs); err != nil {
+			return fmt.Errorf("invalid extensible attributes: %w", err)
+		}
+
+		return nil
+	}
+}
+
+// withExtAttrsValidation adds the validation of the 'ext_attrs' field to the resource's CustomizeDiff function.
+func withExtAttrsValidation(r *schema.Resource, objectTypes []string) *schema.Resource {
+	if _, found := r.Schema["ext_attrs"]; !found {
+		return r
+	}
+
+	customizeDiff := r.CustomizeDiff
+	_, inheritable := r.Schema["inherit_ext_attrs"]
+	validate := validateExtAttrsDiff(objectTypes, inheritable)
+	r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, m); err != nil {
+				return err
+			}
+		}
+		return validate(ctx, d, m)
+	}
+
+	return r
+}
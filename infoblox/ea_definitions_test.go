@@ -0,0 +1,106 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// testEADefConnector serves the given definitions of extensible attributes and counts the requests.
+type testEADefConnector struct {
+	ibclient.IBConnector
+
+	defs  string
+	calls int
+}
+
+func (c *testEADefConnector) GetObject(obj ibclient.IBObject, ref string, qp *ibclient.QueryParams, res interface{}) error {
+	c.calls++
+	return json.Unmarshal([]byte(c.defs), res)
+}
+
+const testEADefs = `[
+	{"name": "Site", "type": "STRING"},
+	{"name": "Owner", "type": "STRING", "flags": "M", "allowed_object_types": ["ARecord", "Network"]},
+	{"name": "Priority", "type": "INTEGER", "min": 1, "max": 10},
+	{"name": "Expires", "type": "DATE"},
+	{"name": "Contact", "type": "EMAIL"},
+	{"name": "Tier", "type": "ENUM", "flags": "V", "list_values": [{"value": "gold"}, {"value": "silver"}]},
+	{"name": "Region", "type": "STRING", "flags": "MI", "allowed_object_types": ["Network"]}
+]`
+
+func TestEADefinitionCache(t *testing.T) {
+	conn := &testEADefConnector{defs: testEADefs}
+	provider := &providerConnector{IBConnector: conn, eaDefs: &eaDefinitionCache{}}
+
+	for i := 0; i < 3; i++ {
+		defs, err := eaDefinitions(provider)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(defs) != 7 {
+			t.Fatalf("expected 7 definitions, got %d", len(defs))
+		}
+	}
+	if conn.calls != 1 {
+		t.Fatalf("expected the definitions to be loaded once, got %d requests", conn.calls)
+	}
+
+	if !checkEARequirement("Owner", provider) || checkEARequirement("Site", provider) {
+		t.Fatal("unexpected requirement of extensible attributes")
+	}
+	// An unknown extensible attribute is not mandatory.
+	if checkEARequirement("Unknown", provider) {
+		t.Fatal("expected an unknown extensible attribute not to be mandatory")
+	}
+}
+
+func TestCheckEAsAgainstDefinitions(t *testing.T) {
+	defs, err := loadEADefinitions(&testEADefConnector{defs: testEADefs})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		extAttrs    string
+		objectTypes []string
+		isNewObject bool
+		inheritsEAs bool
+		expectedErr string
+	}{
+		{`{"Site": "HQ", "Owner": "netops", "Priority": 5, "Expires": "2030-01-31", "Contact": "noc@example.com", "Tier": ["gold", "silver"]}`,
+			[]string{"ARecord"}, true, false, ""},
+		{`{"Site": "HQ"}`, []string{"CNAMERecord"}, true, false, ""},
+		{`{"Site": "HQ"}`, []string{"ARECORD"}, true, false, "mandatory extensible attribute 'Owner' is not set"},
+		{`{"Site": "HQ"}`, []string{"ARecord"}, false, false, ""},
+		{`{"Building": "B1"}`, []string{"CNAMERecord"}, true, false, "extensible attribute 'Building' is not defined on NIOS"},
+		{`{"Priority": 5.5}`, nil, true, false, "'5.5' is not an integer"},
+		{`{"Priority": "high"}`, nil, true, false, "'high' is not an integer"},
+		{`{"Priority": 11}`, nil, true, false, "11 is greater than the maximum value 10"},
+		{`{"Expires": "next week"}`, nil, true, false, "'next week' is not a date"},
+		{`{"Expires": 1893456000}`, nil, true, false, ""},
+		{`{"Contact": "NOC <noc@example.com>"}`, nil, true, false, "is not an email address"},
+		{`{"Tier": "bronze"}`, nil, true, false, "'bronze' is not one of the allowed values: 'gold', 'silver'"},
+		{`{"Site": ["HQ", "DC1"]}`, nil, true, false, "multiple values are not allowed"},
+		{`{"Owner": "netops"}`, []string{"Network"}, true, false, "mandatory extensible attribute 'Region' is not set"},
+		// An inheritable mandatory EA may be supplied by the parent object, unlike a non-inheritable one.
+		{`{"Owner": "netops"}`, []string{"Network"}, true, true, ""},
+		{`{"Site": "HQ"}`, []string{"Network"}, true, true, "mandatory extensible attribute 'Owner' is not set"},
+	}
+
+	for i, tc := range cases {
+		extAttrs, err := terraformDeserializeEAs(tc.extAttrs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = checkEAsAgainstDefinitions(extAttrs, defs, tc.objectTypes, tc.isNewObject, tc.inheritsEAs)
+		if tc.expectedErr == "" && err != nil {
+			t.Fatalf("test case %d: unexpected error: %s", i, err)
+		}
+		if tc.expectedErr != "" && (err == nil || !strings.Contains(err.Error(), tc.expectedErr)) {
+			t.Fatalf("test case %d: expected an error containing '%s', got %v", i, tc.expectedErr, err)
+		}
+	}
+}
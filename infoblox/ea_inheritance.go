@@ -0,0 +1,155 @@
+package infoblox
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// The go-client's EA type keeps only the values of extensible attributes, so their inheritance
+// is read and updated with the types below.
+
+// eaInheritanceValue is a value of an extensible attribute along with the details of its inheritance.
+type eaInheritanceValue struct {
+	Value interface{} `json:"value"`
+
+	// Set by NIOS for a value, inherited from the parent object.
+	InheritanceSource *eaInheritanceSource `json:"inheritance_source,omitempty"`
+
+	// 'INHERIT' makes the object inherit the value from its parent object.
+	InheritanceOperation string `json:"inheritance_operation,omitempty"`
+
+	// Pushes the value down to the object's descendants.
+	DescendantsAction *eaDescendantsAction `json:"descendants_action,omitempty"`
+}
+
+type eaInheritanceSource struct {
+	Ref string `json:"_ref"`
+}
+
+type eaDescendantsAction struct {
+	OptionDeleteEA  string `json:"option_delete_ea,omitempty"`
+	OptionWithEA    string `json:"option_with_ea,omitempty"`
+	OptionWithoutEA string `json:"option_without_ea,omitempty"`
+}
+
+// eaInheritanceObject is a NIOS object of any type, limited to its extensible attributes.
+type eaInheritanceObject struct {
+	ibclient.IBBase `json:"-"`
+	objectType      string
+	Ea              map[string]eaInheritanceValue `json:"extattrs"`
+}
+
+func newEAInheritanceObject(ref string) *eaInheritanceObject {
+	obj := &eaInheritanceObject{objectType: strings.SplitN(ref, "/", 2)[0]}
+	obj.SetReturnFields([]string{"extattrs"})
+
+	return obj
+}
+
+func (obj *eaInheritanceObject) ObjectType() string {
+	return obj.objectType
+}
+
+// getInheritedEAs returns the extensible attributes of a NIOS object, which are inherited from its parent object.
+func getInheritedEAs(conn ibclient.IBConnector, ref string) (map[string]interface{}, error) {
+	var res eaInheritanceObject
+	if err := conn.GetObject(newEAInheritanceObject(ref), ref, ibclient.NewQueryParams(false, nil), &res); err != nil {
+		return nil, fmt.Errorf("failed to get the inheritance of extensible attributes: %w", err)
+	}
+
+	inherited := make(map[string]interface{})
+	for name, val := range res.Ea {
+		if val.InheritanceSource != nil {
+			inherited[name] = val.Value
+		}
+	}
+
+	return inherited, nil
+}
+
+// withoutInheritedEAs removes the inherited extensible attributes from the ones read from NIOS.
+func withoutInheritedEAs(niosEAs ibclient.EA, inherited map[string]interface{}) ibclient.EA {
+	res := make(ibclient.EA, len(niosEAs))
+	for name, val := range niosEAs {
+		if _, found := inherited[name]; !found {
+			res[name] = val
+		}
+	}
+
+	return res
+}
+
+// readInheritedEAs sets the 'inherited_ext_attrs' field of a resource, which inherits extensible attributes,
+// to the inherited attributes of the NIOS object.
+func readInheritedEAs(d *schema.ResourceData, conn ibclient.IBConnector, ref string) error {
+	if !d.Get("inherit_ext_attrs").(bool) {
+		return d.Set("inherited_ext_attrs", "")
+	}
+
+	inherited, err := getInheritedEAs(conn, ref)
+	if err != nil {
+		return err
+	}
+	eaJSON, err := terraformSerializeEAs(inherited)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("inherited_ext_attrs", eaJSON)
+}
+
+// updateEAInheritance restores the inheritance of the attributes, which the object has inherited before the update
+// and are not set by the resource, since the go-client updates the values of all the attributes as local ones.
+// With pushDown, the attributes set by the resource are pushed down to the object's descendants, which do not
+// set them themselves. extAttrs are all the attributes of the object, as they have been updated.
+func updateEAInheritance(d *schema.ResourceData, m interface{}, ref string, extAttrs ibclient.EA, pushDown bool) error {
+	if !d.Get("inherit_ext_attrs").(bool) {
+		return nil
+	}
+
+	terraformEAs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return err
+	}
+	inherited, err := terraformDeserializeEAs(d.Get("inherited_ext_attrs").(string))
+	if err != nil {
+		return err
+	}
+	pushDown = pushDown && d.HasChanges("ext_attrs", "extensible_attributes")
+
+	conn := m.(ibclient.IBConnector)
+	// Only inheritable attributes may be pushed down; without the definitions, NIOS checks that.
+	defs, _ := eaDefinitions(conn)
+
+	obj := newEAInheritanceObject(ref)
+	obj.Ea = make(map[string]eaInheritanceValue, len(extAttrs))
+	changed := false
+	for name, val := range extAttrs {
+		eaVal := eaInheritanceValue{Value: val}
+		_, isTerraformEA := terraformEAs[name]
+		if _, isInherited := inherited[name]; isInherited && !isTerraformEA {
+			eaVal.InheritanceOperation = "INHERIT"
+			changed = true
+		} else if pushDown && isTerraformEA && (defs[name] == nil || hasEAFlag(defs[name], "I")) {
+			eaVal.DescendantsAction = &eaDescendantsAction{
+				OptionDeleteEA:  "REMOVE",
+				OptionWithEA:    "RETAIN",
+				OptionWithoutEA: "INHERIT",
+			}
+			changed = true
+		}
+		obj.Ea[name] = eaVal
+	}
+	if !changed {
+		return nil
+	}
+
+	if _, err = conn.UpdateObject(obj, ref); err != nil {
+		return fmt.Errorf("failed to update the inheritance of extensible attributes: %w", err)
+	}
+
+	return nil
+}
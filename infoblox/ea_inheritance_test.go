@@ -0,0 +1,113 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+// testEAInheritanceConnector serves an object with the given extensible attributes and records the updates.
+type testEAInheritanceConnector struct {
+	ibclient.IBConnector
+
+	obj     string
+	updates []string
+}
+
+func (c *testEAInheritanceConnector) GetObject(obj ibclient.IBObject, ref string, qp *ibclient.QueryParams, res interface{}) error {
+	return json.Unmarshal([]byte(c.obj), res)
+}
+
+func (c *testEAInheritanceConnector) UpdateObject(obj ibclient.IBObject, ref string) (string, error) {
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	c.updates = append(c.updates, string(body))
+
+	return ref, nil
+}
+
+func TestGetInheritedEAs(t *testing.T) {
+	conn := &testEAInheritanceConnector{obj: `{
+		"_ref": "network/ZG5zLm5ldHdvcmskMTAuMC4wLjAvMjQvMA:10.0.0.0/24/default",
+		"extattrs": {
+			"Site": {"value": "HQ", "inheritance_source": {"_ref": "networkcontainer/ZG5zLm5ldHdvcmtfY29udGFpbmVyJDEwLjAuMC4wLzE2LzA:10.0.0.0/16/default"}},
+			"Owner": {"value": "netops"}
+		}
+	}`}
+
+	inherited, err := getInheritedEAs(conn, "network/ZG5zLm5ldHdvcmskMTAuMC4wLjAvMjQvMA:10.0.0.0/24/default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(inherited, map[string]interface{}{"Site": "HQ"}) {
+		t.Fatalf("unexpected inherited EAs: %v", inherited)
+	}
+
+	niosEAs := withoutInheritedEAs(ibclient.EA{"Site": "HQ", "Owner": "netops"}, inherited)
+	if !reflect.DeepEqual(niosEAs, ibclient.EA{"Owner": "netops"}) {
+		t.Fatalf("unexpected EAs: %v", niosEAs)
+	}
+}
+
+func TestUpdateEAInheritance(t *testing.T) {
+	const ref = "network/ZG5zLm5ldHdvcmskMTAuMC4wLjAvMjQvMA:10.0.0.0/24/default"
+	conn := &testEAInheritanceConnector{}
+	m := &providerConnector{
+		IBConnector: conn,
+		eaDefs: &eaDefinitionCache{defs: map[string]*ibclient.EADefinition{
+			"Location": {Name: utils.StringPtr("Location"), Type: "STRING", Flags: utils.StringPtr("I")},
+			"Owner":    {Name: utils.StringPtr("Owner"), Type: "STRING"},
+		}},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceIPv4Network().Schema, map[string]interface{}{
+		"cidr":              "10.0.0.0/24",
+		"ext_attrs":         `{"Location":"DC1","Owner":"netops"}`,
+		"inherit_ext_attrs": true,
+	})
+	if err := d.Set("inherited_ext_attrs", `{"Site":"HQ"}`); err != nil {
+		t.Fatal(err)
+	}
+
+	// Site stays inherited, the inheritable Location is pushed down to descendants.
+	extAttrs := ibclient.EA{"Location": "DC1", "Owner": "netops", "Site": "HQ"}
+	if err := updateEAInheritance(d, m, ref, extAttrs, true); err != nil {
+		t.Fatal(err)
+	}
+	if len(conn.updates) != 1 {
+		t.Fatalf("expected a single update, got %d", len(conn.updates))
+	}
+	var body struct {
+		Ea map[string]eaInheritanceValue `json:"extattrs"`
+	}
+	if err := json.Unmarshal([]byte(conn.updates[0]), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Ea["Site"].InheritanceOperation != "INHERIT" || body.Ea["Site"].DescendantsAction != nil {
+		t.Fatalf("expected Site to be inherited: %s", conn.updates[0])
+	}
+	if body.Ea["Location"].DescendantsAction == nil || body.Ea["Location"].DescendantsAction.OptionWithoutEA != "INHERIT" {
+		t.Fatalf("expected Location to be pushed down: %s", conn.updates[0])
+	}
+	if body.Ea["Owner"].DescendantsAction != nil || body.Ea["Owner"].InheritanceOperation != "" || body.Ea["Owner"].Value != "netops" {
+		t.Fatalf("expected Owner to be a local value: %s", conn.updates[0])
+	}
+
+	// Without inheritance nothing is updated.
+	d = schema.TestResourceDataRaw(t, resourceIPv4Network().Schema, map[string]interface{}{
+		"cidr":      "10.0.0.0/24",
+		"ext_attrs": `{"Location":"DC1"}`,
+	})
+	if err := updateEAInheritance(d, m, ref, extAttrs, true); err != nil {
+		t.Fatal(err)
+	}
+	if len(conn.updates) != 1 {
+		t.Fatalf("expected no more updates, got %d", len(conn.updates))
+	}
+}
@@ -0,0 +1,270 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// extensibleAttributesSchema describes the 'extensible_attributes' blocks of a resource,
+// a structured alternative to its JSON-formatted 'ext_attrs' field.
+func extensibleAttributesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Description: "Extensible attributes of the object, one block per attribute. " +
+			"May be used along with the 'ext_attrs' field, but an attribute must not be set in both of them.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the extensible attribute.",
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Value of the extensible attribute. The value of an INTEGER attribute is converted " +
+						"to a number according to the attribute's definition on NIOS.",
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Values of a multi-value extensible attribute, instead of 'value'.",
+				},
+				"inherit": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+					Description: "The attribute's value is inherited from the parent object and is not managed by the resource. " +
+						"'value' and 'values' must not be set then.",
+				},
+			},
+		},
+	}
+}
+
+// resourceFieldGetter is implemented both by schema.ResourceData and schema.ResourceDiff.
+type resourceFieldGetter interface {
+	Get(key string) interface{}
+}
+
+// terraformExtAttrs returns the extensible attributes of a resource, set either by its 'ext_attrs' field
+// or by its 'extensible_attributes' blocks. Inherited attributes are not included.
+func terraformExtAttrs(d resourceFieldGetter, m interface{}) (map[string]interface{}, error) {
+	blocks, _ := d.Get("extensible_attributes").(*schema.Set)
+
+	return mergeExtAttrBlocks(d.Get("ext_attrs").(string), blocks, m)
+}
+
+// terraformExtAttrsChange is the same as terraformExtAttrs, for the previous and the new values of a resource.
+func terraformExtAttrsChange(d *schema.ResourceData, m interface{}) (
+	oldExtAttrs, newExtAttrs map[string]interface{},
+	err error) {
+
+	oldJSON, newJSON := d.GetChange("ext_attrs")
+	oldBlocks, newBlocks := d.GetChange("extensible_attributes")
+
+	oldBlockSet, _ := oldBlocks.(*schema.Set)
+	if oldExtAttrs, err = mergeExtAttrBlocks(oldJSON.(string), oldBlockSet, m); err != nil {
+		return nil, nil, err
+	}
+	newBlockSet, _ := newBlocks.(*schema.Set)
+	if newExtAttrs, err = mergeExtAttrBlocks(newJSON.(string), newBlockSet, m); err != nil {
+		return nil, nil, err
+	}
+
+	return oldExtAttrs, newExtAttrs, nil
+}
+
+func mergeExtAttrBlocks(extAttrJSON string, blocks *schema.Set, m interface{}) (map[string]interface{}, error) {
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return nil, err
+	}
+	if blocks == nil || blocks.Len() == 0 {
+		return extAttrs, nil
+	}
+
+	// The definitions are only needed to convert values, which NIOS would reject otherwise;
+	// if they cannot be read, the values are sent as strings.
+	var defs map[string]*ibclient.EADefinition
+	if conn, ok := m.(ibclient.IBConnector); ok {
+		defs, _ = eaDefinitions(conn)
+	}
+
+	for _, b := range blocks.List() {
+		block := b.(map[string]interface{})
+		name := block["name"].(string)
+		if _, found := extAttrs[name]; found {
+			return nil, fmt.Errorf(
+				"extensible attribute '%s' must not be set in both 'ext_attrs' and 'extensible_attributes' fields", name)
+		}
+
+		value := block["value"].(string)
+		values := block["values"].([]interface{})
+		switch {
+		case block["inherit"].(bool):
+			if value != "" || len(values) > 0 {
+				return nil, fmt.Errorf("the value of the inherited extensible attribute '%s' must not be set", name)
+			}
+			continue
+		case value != "" && len(values) > 0:
+			return nil, fmt.Errorf("only one of 'value' and 'values' is allowed for the extensible attribute '%s'", name)
+		case value == "" && len(values) == 0:
+			return nil, fmt.Errorf("either 'value' or 'values' is required for the extensible attribute '%s'", name)
+		}
+
+		eadef := defs[name]
+		if len(values) == 0 {
+			extAttrs[name] = eaBlockValue(value, eadef)
+			continue
+		}
+		list := make([]interface{}, 0, len(values))
+		for _, val := range values {
+			strVal, _ := val.(string)
+			list = append(list, eaBlockValue(strVal, eadef))
+		}
+		extAttrs[name] = list
+	}
+
+	return extAttrs, nil
+}
+
+func eaBlockValue(value string, eadef *ibclient.EADefinition) interface{} {
+	if eadef != nil && eadef.Type == "INTEGER" {
+		if num, err := strconv.Atoi(value); err == nil {
+			return num
+		}
+	}
+
+	return value
+}
+
+// setTerraformExtAttrs sets the extensible attributes, read from NIOS, back to the resource: the ones configured
+// by 'extensible_attributes' blocks go to the blocks, and all the others go to the 'ext_attrs' field.
+// Inherited attributes keep their blocks as is.
+func setTerraformExtAttrs(d *schema.ResourceData, eaJSON string) error {
+	extAttrs, err := terraformDeserializeEAs(eaJSON)
+	if err != nil {
+		return err
+	}
+
+	blocks, _ := d.Get("extensible_attributes").(*schema.Set)
+	if blocks != nil {
+		newBlocks := make([]interface{}, 0, blocks.Len())
+		for _, b := range blocks.List() {
+			block := b.(map[string]interface{})
+			if block["inherit"].(bool) {
+				newBlocks = append(newBlocks, block)
+				continue
+			}
+			name := block["name"].(string)
+			val, found := extAttrs[name]
+			if !found {
+				continue
+			}
+			delete(extAttrs, name)
+			newBlocks = append(newBlocks, eaBlock(name, val, len(block["values"].([]interface{})) > 0))
+		}
+		if err = d.Set("extensible_attributes", newBlocks); err != nil {
+			return err
+		}
+	}
+
+	eaJSON, err = terraformSerializeEAs(extAttrs)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("ext_attrs", eaJSON)
+}
+
+// eaBlock makes an 'extensible_attributes' block of the attribute's value, with the 'values' field
+// for a multi-value attribute or if the block has used it before.
+func eaBlock(name string, val interface{}, multiValue bool) map[string]interface{} {
+	block := map[string]interface{}{
+		"name":    name,
+		"value":   "",
+		"values":  []interface{}{},
+		"inherit": false,
+	}
+
+	list, isList := val.([]interface{})
+	if !isList && !multiValue {
+		block["value"] = eaValueString(val)
+		return block
+	}
+	if !isList {
+		list = []interface{}{val}
+	}
+	values := make([]interface{}, 0, len(list))
+	for _, item := range list {
+		values = append(values, eaValueString(item))
+	}
+	block["values"] = values
+
+	return block
+}
+
+func eaValueString(val interface{}) string {
+	switch v := val.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	return fmt.Sprint(val)
+}
+
+// withExtAttrsStateUpgrade sets the schema version of a resource with 'extensible_attributes' blocks,
+// which have been added in the version 1, and the upgrade of the state of the version 0 of the given type.
+// Resources without a version 0 state, those added along with the blocks or later, are left as they are.
+func withExtAttrsStateUpgrade(r *schema.Resource, v0Type cty.Type) *schema.Resource {
+	if _, found := r.Schema["extensible_attributes"]; !found || v0Type == cty.NilType {
+		return r
+	}
+
+	r.SchemaVersion = 1
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    v0Type,
+			Upgrade: upgradeExtAttrsStateV0,
+		},
+	}
+
+	return r
+}
+
+// upgradeExtAttrsStateV0 keeps the 'ext_attrs' field of the state, re-encoding it the way the provider does
+// on reading an object, so that a refresh does not change it. No blocks are added: the attributes move to
+// 'extensible_attributes' blocks once they are configured so.
+func upgradeExtAttrsStateV0(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	extAttrJSON, _ := rawState["ext_attrs"].(string)
+	if extAttrJSON == "" {
+		return rawState, nil
+	}
+	var extAttrs map[string]interface{}
+	if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+		return nil, fmt.Errorf("cannot process 'ext_attrs' field of the state: %w", err)
+	}
+	eaJSON, err := terraformSerializeEAs(extAttrs)
+	if err != nil {
+		return nil, err
+	}
+	rawState["ext_attrs"] = eaJSON
+
+	return rawState, nil
+}
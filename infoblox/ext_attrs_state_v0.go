@@ -0,0 +1,440 @@
+package infoblox
+
+import "github.com/hashicorp/go-cty/cty"
+
+// extAttrsStateV0Types are the types of the version 0 state of the resources which have existed before the
+// 'extensible_attributes' blocks were added. They are frozen: fields added to the resources later must not
+// be added here, the upgrade of a version 0 state is checked against its type as it was.
+var extAttrsStateV0Types = map[string]cty.Type{
+	"infoblox_a_record": cty.Object(map[string]cty.Type{
+		"cidr":          cty.String,
+		"comment":       cty.String,
+		"dns_view":      cty.String,
+		"ext_attrs":     cty.String,
+		"filter_params": cty.String,
+		"fqdn":          cty.String,
+		"id":            cty.String,
+		"internal_id":   cty.String,
+		"ip_addr":       cty.String,
+		"network_view":  cty.String,
+		"ref":           cty.String,
+		"ttl":           cty.Number,
+	}),
+	"infoblox_aaaa_record": cty.Object(map[string]cty.Type{
+		"cidr":          cty.String,
+		"comment":       cty.String,
+		"dns_view":      cty.String,
+		"ext_attrs":     cty.String,
+		"filter_params": cty.String,
+		"fqdn":          cty.String,
+		"id":            cty.String,
+		"internal_id":   cty.String,
+		"ipv6_addr":     cty.String,
+		"network_view":  cty.String,
+		"ref":           cty.String,
+		"ttl":           cty.Number,
+	}),
+	"infoblox_alias_record": cty.Object(map[string]cty.Type{
+		"comment":     cty.String,
+		"disable":     cty.Bool,
+		"dns_view":    cty.String,
+		"ext_attrs":   cty.String,
+		"id":          cty.String,
+		"internal_id": cty.String,
+		"name":        cty.String,
+		"ref":         cty.String,
+		"target_name": cty.String,
+		"target_type": cty.String,
+		"ttl":         cty.Number,
+	}),
+	"infoblox_cname_record": cty.Object(map[string]cty.Type{
+		"alias":       cty.String,
+		"canonical":   cty.String,
+		"comment":     cty.String,
+		"dns_view":    cty.String,
+		"ext_attrs":   cty.String,
+		"id":          cty.String,
+		"internal_id": cty.String,
+		"ref":         cty.String,
+		"ttl":         cty.Number,
+	}),
+	"infoblox_dns_view": cty.Object(map[string]cty.Type{
+		"comment":      cty.String,
+		"ext_attrs":    cty.String,
+		"id":           cty.String,
+		"internal_id":  cty.String,
+		"name":         cty.String,
+		"network_view": cty.String,
+		"ref":          cty.String,
+	}),
+	"infoblox_dtc_lbdn": cty.Object(map[string]cty.Type{
+		"auth_zones": cty.List(cty.Object(map[string]cty.Type{
+			"dns_view": cty.String,
+			"fqdn":     cty.String,
+		})),
+		"auto_consolidated_monitors": cty.Bool,
+		"comment":                    cty.String,
+		"disable":                    cty.Bool,
+		"ext_attrs":                  cty.String,
+		"id":                         cty.String,
+		"internal_id":                cty.String,
+		"lb_method":                  cty.String,
+		"name":                       cty.String,
+		"patterns":                   cty.List(cty.String),
+		"persistence":                cty.Number,
+		"pools": cty.List(cty.Object(map[string]cty.Type{
+			"pool":  cty.String,
+			"ratio": cty.Number,
+		})),
+		"priority": cty.Number,
+		"ref":      cty.String,
+		"topology": cty.String,
+		"ttl":      cty.Number,
+		"types":    cty.List(cty.String),
+	}),
+	"infoblox_dtc_pool": cty.Object(map[string]cty.Type{
+		"auto_consolidated_monitors": cty.Bool,
+		"availability":               cty.String,
+		"comment":                    cty.String,
+		"consolidated_monitors": cty.List(cty.Object(map[string]cty.Type{
+			"availability":              cty.String,
+			"full_health_communication": cty.Bool,
+			"members":                   cty.List(cty.String),
+			"monitor_name":              cty.String,
+			"monitor_type":              cty.String,
+		})),
+		"disable":                    cty.Bool,
+		"ext_attrs":                  cty.String,
+		"id":                         cty.String,
+		"internal_id":                cty.String,
+		"lb_alternate_method":        cty.String,
+		"lb_alternate_topology":      cty.String,
+		"lb_dynamic_ratio_alternate": cty.String,
+		"lb_dynamic_ratio_preferred": cty.String,
+		"lb_preferred_method":        cty.String,
+		"lb_preferred_topology":      cty.String,
+		"monitors": cty.List(cty.Object(map[string]cty.Type{
+			"monitor_name": cty.String,
+			"monitor_type": cty.String,
+		})),
+		"name":   cty.String,
+		"quorum": cty.Number,
+		"ref":    cty.String,
+		"servers": cty.List(cty.Object(map[string]cty.Type{
+			"ratio":  cty.Number,
+			"server": cty.String,
+		})),
+		"ttl": cty.Number,
+	}),
+	"infoblox_dtc_server": cty.Object(map[string]cty.Type{
+		"auto_create_host_record": cty.Bool,
+		"comment":                 cty.String,
+		"disable":                 cty.Bool,
+		"ext_attrs":               cty.String,
+		"host":                    cty.String,
+		"id":                      cty.String,
+		"internal_id":             cty.String,
+		"monitors": cty.List(cty.Object(map[string]cty.Type{
+			"host":         cty.String,
+			"monitor_name": cty.String,
+			"monitor_type": cty.String,
+		})),
+		"name":             cty.String,
+		"ref":              cty.String,
+		"sni_hostname":     cty.String,
+		"use_sni_hostname": cty.Bool,
+	}),
+	"infoblox_ip_allocation": cty.Object(map[string]cty.Type{
+		"aliases":             cty.List(cty.String),
+		"allocated_ipv4_addr": cty.String,
+		"allocated_ipv6_addr": cty.String,
+		"comment":             cty.String,
+		"disable":             cty.Bool,
+		"dns_view":            cty.String,
+		"enable_dns":          cty.Bool,
+		"ext_attrs":           cty.String,
+		"filter_params":       cty.String,
+		"fqdn":                cty.String,
+		"id":                  cty.String,
+		"internal_id":         cty.String,
+		"ip_address_type":     cty.String,
+		"ipv4_addr":           cty.String,
+		"ipv4_cidr":           cty.String,
+		"ipv6_addr":           cty.String,
+		"ipv6_cidr":           cty.String,
+		"network_view":        cty.String,
+		"ref":                 cty.String,
+		"ttl":                 cty.Number,
+	}),
+	"infoblox_ipv4_fixed_address": cty.Object(map[string]cty.Type{
+		"agent_circuit_id":               cty.String,
+		"agent_remote_id":                cty.String,
+		"client_identifier_prepend_zero": cty.Bool,
+		"comment":                        cty.String,
+		"dhcp_client_identifier":         cty.String,
+		"disable":                        cty.Bool,
+		"ext_attrs":                      cty.String,
+		"id":                             cty.String,
+		"internal_id":                    cty.String,
+		"ipv4addr":                       cty.String,
+		"mac":                            cty.String,
+		"match_client":                   cty.String,
+		"name":                           cty.String,
+		"network":                        cty.String,
+		"network_view":                   cty.String,
+		"options": cty.List(cty.Object(map[string]cty.Type{
+			"name":         cty.String,
+			"num":          cty.Number,
+			"use_option":   cty.Bool,
+			"value":        cty.String,
+			"vendor_class": cty.String,
+		})),
+		"ref":         cty.String,
+		"use_options": cty.Bool,
+	}),
+	"infoblox_ipv4_network": cty.Object(map[string]cty.Type{
+		"allocate_prefix_len": cty.Number,
+		"cidr":                cty.String,
+		"comment":             cty.String,
+		"ext_attrs":           cty.String,
+		"filter_params":       cty.String,
+		"gateway":             cty.String,
+		"id":                  cty.String,
+		"internal_id":         cty.String,
+		"network_view":        cty.String,
+		"object":              cty.String,
+		"parent_cidr":         cty.String,
+		"ref":                 cty.String,
+		"reserve_ip":          cty.Number,
+		"reserve_ipv6":        cty.Number,
+	}),
+	"infoblox_ipv4_network_container": cty.Object(map[string]cty.Type{
+		"allocate_prefix_len": cty.Number,
+		"cidr":                cty.String,
+		"comment":             cty.String,
+		"ext_attrs":           cty.String,
+		"filter_params":       cty.String,
+		"id":                  cty.String,
+		"internal_id":         cty.String,
+		"network_view":        cty.String,
+		"parent_cidr":         cty.String,
+		"ref":                 cty.String,
+	}),
+	"infoblox_ipv4_range": cty.Object(map[string]cty.Type{
+		"comment":              cty.String,
+		"disable":              cty.Bool,
+		"end_addr":             cty.String,
+		"ext_attrs":            cty.String,
+		"failover_association": cty.String,
+		"id":                   cty.String,
+		"internal_id":          cty.String,
+		"member":               cty.Map(cty.String),
+		"ms_server":            cty.String,
+		"name":                 cty.String,
+		"network":              cty.String,
+		"network_view":         cty.String,
+		"options": cty.List(cty.Object(map[string]cty.Type{
+			"name":         cty.String,
+			"num":          cty.Number,
+			"use_option":   cty.Bool,
+			"value":        cty.String,
+			"vendor_class": cty.String,
+		})),
+		"ref":                     cty.String,
+		"server_association_type": cty.String,
+		"start_addr":              cty.String,
+		"template":                cty.String,
+		"use_options":             cty.Bool,
+	}),
+	"infoblox_ipv4_range_template": cty.Object(map[string]cty.Type{
+		"cloud_api_compatible": cty.Bool,
+		"comment":              cty.String,
+		"ext_attrs":            cty.String,
+		"failover_association": cty.String,
+		"id":                   cty.String,
+		"internal_id":          cty.String,
+		"member":               cty.Map(cty.String),
+		"ms_server":            cty.String,
+		"name":                 cty.String,
+		"number_of_addresses":  cty.Number,
+		"offset":               cty.Number,
+		"options": cty.List(cty.Object(map[string]cty.Type{
+			"name":         cty.String,
+			"num":          cty.Number,
+			"use_option":   cty.Bool,
+			"value":        cty.String,
+			"vendor_class": cty.String,
+		})),
+		"ref":                     cty.String,
+		"server_association_type": cty.String,
+		"use_options":             cty.Bool,
+	}),
+	"infoblox_ipv4_shared_network": cty.Object(map[string]cty.Type{
+		"comment":      cty.String,
+		"disable":      cty.Bool,
+		"ext_attrs":    cty.String,
+		"id":           cty.String,
+		"internal_id":  cty.String,
+		"name":         cty.String,
+		"network_view": cty.String,
+		"networks":     cty.List(cty.String),
+		"options": cty.List(cty.Object(map[string]cty.Type{
+			"name":         cty.String,
+			"num":          cty.Number,
+			"use_option":   cty.Bool,
+			"value":        cty.String,
+			"vendor_class": cty.String,
+		})),
+		"ref":         cty.String,
+		"use_options": cty.Bool,
+	}),
+	"infoblox_ipv6_network": cty.Object(map[string]cty.Type{
+		"allocate_prefix_len": cty.Number,
+		"cidr":                cty.String,
+		"comment":             cty.String,
+		"ext_attrs":           cty.String,
+		"filter_params":       cty.String,
+		"gateway":             cty.String,
+		"id":                  cty.String,
+		"internal_id":         cty.String,
+		"network_view":        cty.String,
+		"object":              cty.String,
+		"parent_cidr":         cty.String,
+		"ref":                 cty.String,
+		"reserve_ip":          cty.Number,
+		"reserve_ipv6":        cty.Number,
+	}),
+	"infoblox_ipv6_network_container": cty.Object(map[string]cty.Type{
+		"allocate_prefix_len": cty.Number,
+		"cidr":                cty.String,
+		"comment":             cty.String,
+		"ext_attrs":           cty.String,
+		"filter_params":       cty.String,
+		"id":                  cty.String,
+		"internal_id":         cty.String,
+		"network_view":        cty.String,
+		"parent_cidr":         cty.String,
+		"ref":                 cty.String,
+	}),
+	"infoblox_mx_record": cty.Object(map[string]cty.Type{
+		"comment":        cty.String,
+		"dns_view":       cty.String,
+		"ext_attrs":      cty.String,
+		"fqdn":           cty.String,
+		"id":             cty.String,
+		"internal_id":    cty.String,
+		"mail_exchanger": cty.String,
+		"preference":     cty.Number,
+		"ref":            cty.String,
+		"ttl":            cty.Number,
+	}),
+	"infoblox_network_view": cty.Object(map[string]cty.Type{
+		"comment":     cty.String,
+		"ext_attrs":   cty.String,
+		"id":          cty.String,
+		"internal_id": cty.String,
+		"name":        cty.String,
+		"ref":         cty.String,
+	}),
+	"infoblox_ptr_record": cty.Object(map[string]cty.Type{
+		"cidr":         cty.String,
+		"comment":      cty.String,
+		"dns_view":     cty.String,
+		"ext_attrs":    cty.String,
+		"id":           cty.String,
+		"internal_id":  cty.String,
+		"ip_addr":      cty.String,
+		"network_view": cty.String,
+		"ptrdname":     cty.String,
+		"record_name":  cty.String,
+		"ref":          cty.String,
+		"ttl":          cty.Number,
+	}),
+	"infoblox_srv_record": cty.Object(map[string]cty.Type{
+		"comment":     cty.String,
+		"dns_view":    cty.String,
+		"ext_attrs":   cty.String,
+		"id":          cty.String,
+		"internal_id": cty.String,
+		"name":        cty.String,
+		"port":        cty.Number,
+		"priority":    cty.Number,
+		"ref":         cty.String,
+		"target":      cty.String,
+		"ttl":         cty.Number,
+		"weight":      cty.Number,
+	}),
+	"infoblox_txt_record": cty.Object(map[string]cty.Type{
+		"comment":     cty.String,
+		"dns_view":    cty.String,
+		"ext_attrs":   cty.String,
+		"fqdn":        cty.String,
+		"id":          cty.String,
+		"internal_id": cty.String,
+		"ref":         cty.String,
+		"text":        cty.String,
+		"ttl":         cty.Number,
+	}),
+	"infoblox_zone_auth": cty.Object(map[string]cty.Type{
+		"comment":           cty.String,
+		"ext_attrs":         cty.String,
+		"fqdn":              cty.String,
+		"id":                cty.String,
+		"internal_id":       cty.String,
+		"ns_group":          cty.String,
+		"ref":               cty.String,
+		"restart_if_needed": cty.Bool,
+		"soa_default_ttl":   cty.Number,
+		"soa_expire":        cty.Number,
+		"soa_negative_ttl":  cty.Number,
+		"soa_refresh":       cty.Number,
+		"soa_retry":         cty.Number,
+		"view":              cty.String,
+		"zone_format":       cty.String,
+	}),
+	"infoblox_zone_delegated": cty.Object(map[string]cty.Type{
+		"comment": cty.String,
+		"delegate_to": cty.List(cty.Object(map[string]cty.Type{
+			"address": cty.String,
+			"name":    cty.String,
+		})),
+		"delegated_ttl": cty.Number,
+		"disable":       cty.Bool,
+		"ext_attrs":     cty.String,
+		"fqdn":          cty.String,
+		"id":            cty.String,
+		"internal_id":   cty.String,
+		"locked":        cty.Bool,
+		"ns_group":      cty.String,
+		"ref":           cty.String,
+		"view":          cty.String,
+		"zone_format":   cty.String,
+	}),
+	"infoblox_zone_forward": cty.Object(map[string]cty.Type{
+		"comment":           cty.String,
+		"disable":           cty.Bool,
+		"ext_attrs":         cty.String,
+		"external_ns_group": cty.String,
+		"forward_to": cty.List(cty.Object(map[string]cty.Type{
+			"address": cty.String,
+			"name":    cty.String,
+		})),
+		"forwarders_only": cty.Bool,
+		"forwarding_servers": cty.List(cty.Object(map[string]cty.Type{
+			"forward_to": cty.List(cty.Object(map[string]cty.Type{
+				"address": cty.String,
+				"name":    cty.String,
+			})),
+			"forwarders_only":         cty.Bool,
+			"name":                    cty.String,
+			"use_override_forwarders": cty.Bool,
+		})),
+		"fqdn":        cty.String,
+		"id":          cty.String,
+		"internal_id": cty.String,
+		"ns_group":    cty.String,
+		"ref":         cty.String,
+		"view":        cty.String,
+		"zone_format": cty.String,
+	}),
+}
@@ -0,0 +1,204 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+func testExtAttrsResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ext_attrs": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+		},
+	}
+}
+
+func TestTerraformExtAttrs(t *testing.T) {
+	conn := &providerConnector{
+		eaDefs: &eaDefinitionCache{defs: map[string]*ibclient.EADefinition{
+			"Rack": {Name: utils.StringPtr("Rack"), Type: "INTEGER"},
+		}},
+	}
+
+	d := schema.TestResourceDataRaw(t, testExtAttrsResource().Schema, map[string]interface{}{
+		"ext_attrs": `{"Owner":"netops"}`,
+		"extensible_attributes": []interface{}{
+			map[string]interface{}{"name": "Rack", "value": "12"},
+			map[string]interface{}{"name": "Site", "values": []interface{}{"HQ", "DC1"}},
+			map[string]interface{}{"name": "Tenant", "inherit": true},
+		},
+	})
+	extAttrs, err := terraformExtAttrs(d, conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"Owner": "netops",
+		"Rack":  12,
+		"Site":  []interface{}{"HQ", "DC1"},
+	}
+	if !reflect.DeepEqual(extAttrs, expected) {
+		t.Fatalf("expected EAs %v, got %v", expected, extAttrs)
+	}
+
+	for _, tc := range []struct {
+		extAttrJSON string
+		block       map[string]interface{}
+		expectedErr string
+	}{
+		{`{"Site":"HQ"}`, map[string]interface{}{"name": "Site", "value": "DC1"}, "must not be set in both"},
+		{"", map[string]interface{}{"name": "Site"}, "either 'value' or 'values' is required"},
+		{"", map[string]interface{}{"name": "Site", "value": "HQ", "values": []interface{}{"DC1"}}, "only one of 'value' and 'values'"},
+		{"", map[string]interface{}{"name": "Site", "value": "HQ", "inherit": true}, "must not be set"},
+	} {
+		d = schema.TestResourceDataRaw(t, testExtAttrsResource().Schema, map[string]interface{}{
+			"ext_attrs":             tc.extAttrJSON,
+			"extensible_attributes": []interface{}{tc.block},
+		})
+		if _, err = terraformExtAttrs(d, conn); err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+			t.Fatalf("expected an error containing '%s', got %v", tc.expectedErr, err)
+		}
+	}
+}
+
+func TestSetTerraformExtAttrs(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testExtAttrsResource().Schema, map[string]interface{}{
+		"ext_attrs": `{"Owner":"netops"}`,
+		"extensible_attributes": []interface{}{
+			map[string]interface{}{"name": "Rack", "value": "12"},
+			map[string]interface{}{"name": "Site", "values": []interface{}{"HQ"}},
+			map[string]interface{}{"name": "Tenant", "inherit": true},
+			map[string]interface{}{"name": "Removed", "value": "x"},
+		},
+	})
+
+	// The values come from NIOS: numbers and lists are converted to the blocks' strings.
+	if err := setTerraformExtAttrs(d, `{"Owner":"dns-team","Rack":12,"Site":"HQ","Location":"DC1"}`); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Get("ext_attrs").(string) != `{"Location":"DC1","Owner":"dns-team"}` {
+		t.Fatalf("unexpected 'ext_attrs' field: %s", d.Get("ext_attrs"))
+	}
+	blocks := map[string]map[string]interface{}{}
+	for _, b := range d.Get("extensible_attributes").(*schema.Set).List() {
+		block := b.(map[string]interface{})
+		blocks[block["name"].(string)] = block
+	}
+	if len(blocks) != 3 {
+		t.Fatalf("expected 3 blocks, got %v", blocks)
+	}
+	if blocks["Rack"]["value"] != "12" {
+		t.Fatalf("unexpected block: %v", blocks["Rack"])
+	}
+	if !reflect.DeepEqual(blocks["Site"]["values"], []interface{}{"HQ"}) || blocks["Site"]["value"] != "" {
+		t.Fatalf("unexpected block: %v", blocks["Site"])
+	}
+	if blocks["Tenant"]["inherit"] != true {
+		t.Fatalf("unexpected block: %v", blocks["Tenant"])
+	}
+}
+
+func TestExtAttrsStateUpgrade(t *testing.T) {
+	v0Type := cty.Object(map[string]cty.Type{"ext_attrs": cty.String, "id": cty.String})
+	r := withExtAttrsStateUpgrade(testExtAttrsResource(), v0Type)
+	if r.SchemaVersion != 1 || len(r.StateUpgraders) != 1 {
+		t.Fatalf("expected the schema version 1 with a state upgrader, got %d", r.SchemaVersion)
+	}
+	if !r.StateUpgraders[0].Type.Equals(v0Type) {
+		t.Fatalf("unexpected type of the version 0 state: %#v", r.StateUpgraders[0].Type)
+	}
+
+	// Resources without a version 0 state keep the schema version.
+	r = withExtAttrsStateUpgrade(testExtAttrsResource(), cty.NilType)
+	if r.SchemaVersion != 0 || r.StateUpgraders != nil {
+		t.Fatal("expected no state upgrade")
+	}
+
+	// Resources without extensible attributes keep the schema version.
+	r = withExtAttrsStateUpgrade(&schema.Resource{Schema: map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Required: true},
+	}}, v0Type)
+	if r.SchemaVersion != 0 || r.StateUpgraders != nil {
+		t.Fatal("expected no state upgrade")
+	}
+}
+
+func TestProviderExtAttrsStateUpgrade(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for name := range extAttrsStateV0Types {
+		if _, found := resources[name]; !found {
+			t.Fatalf("no resource '%s' for the version 0 state type", name)
+		}
+	}
+	for name, r := range resources {
+		_, hasV0 := extAttrsStateV0Types[name]
+		if _, found := r.Schema["extensible_attributes"]; !found {
+			hasV0 = false
+		}
+		if hasV0 != (r.SchemaVersion == 1 && len(r.StateUpgraders) == 1) {
+			t.Fatalf("unexpected state upgrade of the resource '%s': schema version %d", name, r.SchemaVersion)
+		}
+	}
+}
+
+func TestUpgradeExtAttrsStateV0(t *testing.T) {
+	// The state of an 'infoblox_a_record' resource as it has been written by the version 0.
+	v0State := `{
+		"cidr": "",
+		"comment": "test record",
+		"dns_view": "default",
+		"ext_attrs": "{\"Tenant ID\":\"terraform_test_tenant\", \"Location\": \"Test loc.\"}",
+		"filter_params": null,
+		"fqdn": "a.test.com",
+		"id": "record:a/ZG5zLmJpbmRfYSQuX2RlZmF1bHQuY29tLnRlc3QsYSwxMC4wLjAuMQ:a.test.com/default",
+		"internal_id": "c8a1e2f0-3a2b-4e5c-9d6f-0a1b2c3d4e5f",
+		"ip_addr": "10.0.0.1",
+		"network_view": "",
+		"ref": "record:a/ZG5zLmJpbmRfYSQuX2RlZmF1bHQuY29tLnRlc3QsYSwxMC4wLjAuMQ:a.test.com/default",
+		"ttl": 3600
+	}`
+	r := Provider().ResourcesMap["infoblox_a_record"]
+	upgrader := r.StateUpgraders[0]
+	if _, err := ctyjson.Unmarshal([]byte(v0State), upgrader.Type); err != nil {
+		t.Fatalf("the state does not conform to the version 0 type: %s", err)
+	}
+
+	var rawState map[string]interface{}
+	if err := json.Unmarshal([]byte(v0State), &rawState); err != nil {
+		t.Fatal(err)
+	}
+	state, err := upgrader.Upgrade(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state["ext_attrs"] != `{"Location":"Test loc.","Tenant ID":"terraform_test_tenant"}` {
+		t.Fatalf("unexpected 'ext_attrs' field: %v", state["ext_attrs"])
+	}
+	if state["fqdn"] != "a.test.com" || state["ttl"] != float64(3600) {
+		t.Fatalf("expected the other fields to be kept, got %v", state)
+	}
+
+	// The upgraded state must be readable with the current schema.
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ctyjson.Unmarshal(upgraded, r.CoreConfigSchema().ImpliedType()); err != nil {
+		t.Fatalf("the upgraded state does not conform to the current schema: %s", err)
+	}
+}
@@ -2,14 +2,20 @@ package infoblox
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"github.com/google/uuid"
 	log "github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"math"
+	"net"
+	"net/url"
+	"os"
 	"reflect"
 	"sort"
 	"strings"
@@ -140,26 +146,103 @@ func isNotFoundError(err error) bool {
 	return false
 }
 
+// eaObjectTypes maps resources to the types of NIOS objects they manage, as used in the definitions
+// of extensible attributes to restrict the objects the attributes apply to.
+var eaObjectTypes = map[string][]string{
+	"infoblox_network_view":                 {"NetworkView"},
+	"infoblox_ipv4_network_container":       {"NetworkContainer"},
+	"infoblox_ipv6_network_container":       {"IPv6NetworkContainer"},
+	"infoblox_ipv4_network":                 {"Network"},
+	"infoblox_ipv6_network":                 {"IPv6Network"},
+	"infoblox_ip_allocation":                {"HostRecord"},
+	"infoblox_a_record":                     {"ARecord"},
+	"infoblox_aaaa_record":                  {"AAAARecord"},
+	"infoblox_cname_record":                 {"CNAMERecord"},
+	"infoblox_ptr_record":                   {"PTRRecord"},
+	"infoblox_zone_delegated":               {"DelegatedZone"},
+	"infoblox_txt_record":                   {"TXTRecord"},
+	"infoblox_mx_record":                    {"MXRecord"},
+	"infoblox_srv_record":                   {"SRVRecord"},
+	"infoblox_dns_view":                     {"View"},
+	"infoblox_zone_auth":                    {"AuthZone"},
+	"infoblox_zone_forward":                 {"ForwardZone"},
+	"infoblox_dtc_lbdn":                     {"DtcLbdn"},
+	"infoblox_dtc_pool":                     {"DtcPool"},
+	"infoblox_dtc_server":                   {"DtcServer"},
+	"infoblox_ipv4_fixed_address":           {"FixedAddress"},
+	"infoblox_alias_record":                 {"AliasRecord"},
+	"infoblox_ns_record":                    {"NSRecord"},
+	"infoblox_ipv4_range":                   {"Range"},
+	"infoblox_ipv4_range_template":          {"RangeTemplate"},
+	"infoblox_ipv4_shared_network":          {"SharedNetwork"},
+	"infoblox_host_record":                  {"HostRecord"},
+	"infoblox_ipv6_fixed_address":           {"IPv6FixedAddress"},
+	"infoblox_ipv6_range":                   {"IPv6Range"},
+	"infoblox_ipv6_shared_network":          {"IPv6SharedNetwork"},
+	"infoblox_svcb_record":                  {"SVCBRecord"},
+	"infoblox_https_record":                 {"HTTPSRecord"},
+	"infoblox_caa_record":                   {"CAARecord"},
+	"infoblox_naptr_record":                 {"NAPTRRecord"},
+	"infoblox_dname_record":                 {"DNAMERecord"},
+	"infoblox_tlsa_record":                  {"TLSARecord"},
+	"infoblox_unknown_record":               {"UnknownRecord"},
+	"infoblox_zone_stub":                    {"StubZone"},
+	"infoblox_zone_rp":                      {"ResponsePolicyZone"},
+	"infoblox_rpz_rule":                     {"RpzCnameRecord", "RpzCnameIpaddressRecord", "RpzCnameClientIpaddressRecord"},
+	"infoblox_ns_group":                     {"NsGroup"},
+	"infoblox_ns_group_delegation":          {"NsGroupDelegation"},
+	"infoblox_ns_group_forwarding_member":   {"NsGroupForwardingMember"},
+	"infoblox_ns_group_forward_stub_server": {"NsGroupForwardStubServer"},
+	"infoblox_ns_group_stub_member":         {"NsGroupStubMember"},
+	"infoblox_named_acl":                    {"NamedACL"},
+}
+
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"server": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_SERVER", nil),
-				Description: "Infoblox server IP address.",
+				Description: "Infoblox server IP address. A comma-separated list of addresses is accepted as well, " +
+					"they are tried in order if the grid master is not available.",
+			},
+			"servers": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"server"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "List of Infoblox server IP addresses (grid master and grid master candidates), " +
+					"each optionally with a port. They are tried in order if the grid master is not available.",
 			},
 			"username": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_USERNAME", nil),
-				Description: "User to authenticate with Infoblox server.",
+				Description: "User to authenticate with Infoblox server. Not required when a client certificate is used.",
 			},
 			"password": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_PASSWORD", nil),
-				Description: "Password to authenticate with Infoblox server.",
+				Description: "Password to authenticate with Infoblox server. Not required when a client certificate is used.",
+			},
+			"client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_CLIENT_CERT", nil),
+				Description: "Client certificate to authenticate with Infoblox server, " +
+					"either a path to a PEM-encoded file or the PEM content itself.",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_CLIENT_KEY", nil),
+				Description: "Private key of the client certificate, " +
+					"either a path to a PEM-encoded file or the PEM content itself.",
 			},
 			"wapi_version": {
 				Type:        schema.TypeString,
@@ -180,6 +263,21 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("SSLMODE", "false"),
 				Description: "If set, Infoblox client will permit unverifiable SSL certificates.",
 			},
+			"ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_CA_CERT_FILE", nil),
+				Description: "CA bundle to verify the Infoblox server's certificate with, " +
+					"either a path to a PEM-encoded file or the PEM content itself. " +
+					"When set, certificate verification is enabled regardless of 'sslmode'.",
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_PROXY_URL", nil),
+				Description: "URL of the HTTP proxy to connect to the Infoblox server through. " +
+					"If not set, the standard HTTPS_PROXY and NO_PROXY environment variables are used.",
+			},
 			"connect_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -192,36 +290,110 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("POOL_CONNECTIONS", "10"),
 				Description: "Maximum number of connections to establish to the Infoblox server. Zero means unlimited.",
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("INFOBLOX_MAX_RETRIES", 3),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "Maximum number of retries of a WAPI request which failed because of a transient error. " +
+					"Zero disables retries.",
+			},
+			"retry_wait_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("INFOBLOX_RETRY_WAIT_MIN", 1),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Minimum time to wait before retrying a WAPI request, in seconds.",
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("INFOBLOX_RETRY_WAIT_MAX", 30),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum time to wait before retrying a WAPI request, in seconds.",
+			},
+			"default_ext_attrs": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+				Description: "Extensible attributes to be set on every object created or updated by a resource, as a map in JSON format. " +
+					"Values specified in a resource's 'ext_attrs' field or 'extensible_attributes' blocks override them.",
+			},
+			"ignore_ext_attrs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Names of extensible attributes which are managed on the NIOS side and must never be " +
+					"changed or removed by a resource, nor cause a difference. " +
+					"A name ending with '*' matches all the extensible attributes with the given prefix.",
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("INFOBLOX_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of WAPI requests in flight at the same time. Zero means unlimited.",
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("INFOBLOX_REQUESTS_PER_SECOND", 0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Maximum rate of WAPI requests, per second. Zero means unlimited.",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"infoblox_network_view":           resourceNetworkView(),
-			"infoblox_ipv4_network_container": resourceIPv4NetworkContainer(),
-			"infoblox_ipv6_network_container": resourceIPv6NetworkContainer(),
-			"infoblox_ipv4_network":           resourceIPv4Network(),
-			"infoblox_ipv6_network":           resourceIPv6Network(),
-			"infoblox_ip_allocation":          resourceIPAllocation(),
-			"infoblox_ip_association":         resourceIpAssociationInit(),
-			"infoblox_a_record":               resourceARecord(),
-			"infoblox_aaaa_record":            resourceAAAARecord(),
-			"infoblox_cname_record":           resourceCNAMERecord(),
-			"infoblox_ptr_record":             resourcePTRRecord(),
-			"infoblox_zone_delegated":         resourceZoneDelegated(),
-			"infoblox_txt_record":             resourceTXTRecord(),
-			"infoblox_mx_record":              resourceMXRecord(),
-			"infoblox_srv_record":             resourceSRVRecord(),
-			"infoblox_dns_view":               resourceDNSView(),
-			"infoblox_zone_auth":              resourceZoneAuth(),
-			"infoblox_zone_forward":           resourceZoneForward(),
-			"infoblox_dtc_lbdn":               resourceDtcLbdnRecord(),
-			"infoblox_dtc_pool":               resourceDtcPool(),
-			"infoblox_dtc_server":             resourceDtcServer(),
-			"infoblox_ipv4_fixed_address":     resourceFixedRecord(),
-			"infoblox_alias_record":           resourceAliasRecord(),
-			"infoblox_ns_record":              resourceNSRecord(),
-			"infoblox_ipv4_range":             resourceRange(),
-			"infoblox_ipv4_range_template":    resourceRangeTemplate(),
-			"infoblox_ipv4_shared_network":    resourceIpv4SharedNetwork(),
+			"infoblox_network_view":                 resourceNetworkView(),
+			"infoblox_ipv4_network_container":       resourceIPv4NetworkContainer(),
+			"infoblox_ipv6_network_container":       resourceIPv6NetworkContainer(),
+			"infoblox_ipv4_network":                 resourceIPv4Network(),
+			"infoblox_ipv6_network":                 resourceIPv6Network(),
+			"infoblox_ip_allocation":                resourceIPAllocation(),
+			"infoblox_ip_association":               resourceIpAssociationInit(),
+			"infoblox_a_record":                     resourceARecord(),
+			"infoblox_aaaa_record":                  resourceAAAARecord(),
+			"infoblox_cname_record":                 resourceCNAMERecord(),
+			"infoblox_ptr_record":                   resourcePTRRecord(),
+			"infoblox_zone_delegated":               resourceZoneDelegated(),
+			"infoblox_txt_record":                   resourceTXTRecord(),
+			"infoblox_mx_record":                    resourceMXRecord(),
+			"infoblox_srv_record":                   resourceSRVRecord(),
+			"infoblox_dns_view":                     resourceDNSView(),
+			"infoblox_zone_auth":                    resourceZoneAuth(),
+			"infoblox_zone_forward":                 resourceZoneForward(),
+			"infoblox_dtc_lbdn":                     resourceDtcLbdnRecord(),
+			"infoblox_dtc_pool":                     resourceDtcPool(),
+			"infoblox_dtc_server":                   resourceDtcServer(),
+			"infoblox_ipv4_fixed_address":           resourceFixedRecord(),
+			"infoblox_alias_record":                 resourceAliasRecord(),
+			"infoblox_ns_record":                    resourceNSRecord(),
+			"infoblox_ipv4_range":                   resourceRange(),
+			"infoblox_ipv4_range_template":          resourceRangeTemplate(),
+			"infoblox_ipv4_shared_network":          resourceIpv4SharedNetwork(),
+			"infoblox_host_record":                  resourceHostRecord(),
+			"infoblox_ipv6_fixed_address":           resourceIpv6FixedAddress(),
+			"infoblox_ipv6_range":                   resourceIpv6Range(),
+			"infoblox_ipv6_range_template":          resourceIpv6RangeTemplate(),
+			"infoblox_ipv6_shared_network":          resourceIpv6SharedNetwork(),
+			"infoblox_svcb_record":                  resourceSVCBRecord(),
+			"infoblox_https_record":                 resourceHTTPSRecord(),
+			"infoblox_caa_record":                   resourceCAARecord(),
+			"infoblox_naptr_record":                 resourceNAPTRRecord(),
+			"infoblox_dname_record":                 resourceDNAMERecord(),
+			"infoblox_tlsa_record":                  resourceTLSARecord(),
+			"infoblox_unknown_record":               resourceUnknownRecord(),
+			"infoblox_zone_stub":                    resourceZoneStub(),
+			"infoblox_zone_rp":                      resourceZoneRp(),
+			"infoblox_rpz_rule":                     resourceRpzRule(),
+			"infoblox_ns_group":                     resourceNsGroup(),
+			"infoblox_ns_group_delegation":          resourceNsGroupDelegation(),
+			"infoblox_ns_group_forwarding_member":   resourceNsGroupForwardingMember(),
+			"infoblox_ns_group_forward_stub_server": resourceNsGroupForwardStubServer(),
+			"infoblox_ns_group_stub_member":         resourceNsGroupStubMember(),
+			"infoblox_named_acl":                    resourceNamedAcl(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"infoblox_ipv4_network":           dataSourceIPv4Network(),
@@ -250,53 +422,318 @@ func Provider() *schema.Provider {
 			"infoblox_ipv4_range":             dataSourceRange(),
 			"infoblox_ipv4_range_template":    dataSourceRangeTemplate(),
 			"infoblox_ipv4_shared_network":    dataSourceIpv4SharedNetwork(),
+			"infoblox_ipv6_fixed_address":     dataSourceIpv6FixedAddress(),
+			"infoblox_ipv6_range":             dataSourceIpv6Range(),
+			"infoblox_ipv6_range_template":    dataSourceIpv6RangeTemplate(),
+			"infoblox_ipv6_shared_network":    dataSourceIpv6SharedNetwork(),
+			"infoblox_svcb_record":            dataSourceSVCBRecord(),
+			"infoblox_https_record":           dataSourceHTTPSRecord(),
+			"infoblox_caa_record":             dataSourceCAARecord(),
+			"infoblox_naptr_record":           dataSourceNAPTRRecord(),
+			"infoblox_dname_record":           dataSourceDNAMERecord(),
+			"infoblox_tlsa_record":            dataSourceTLSARecord(),
+			"infoblox_zone_stub":              dataSourceZoneStub(),
+			"infoblox_ns_group":               dataSourceNsGroup(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
+
+	for name, resource := range provider.ResourcesMap {
+		// Extensible attributes are checked against their definitions at plan time.
+		withExtAttrsValidation(resource, eaObjectTypes[name])
+		// The state written before the 'extensible_attributes' blocks were added is upgraded.
+		withExtAttrsStateUpgrade(resource, extAttrsStateV0Types[name])
+		// WAPI requests are cancelled together with the terraform operation which has sent them.
+		withRequestContext(resource)
+	}
+	for _, dataSource := range provider.DataSourcesMap {
+		withRequestContext(dataSource)
+	}
+
+	return provider
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 
-	if d.Get("password") == "" {
+	authConfig, err := buildAuthConfig(d)
+	if err != nil {
 		return nil, diag.Diagnostics{diag.Diagnostic{
 			Severity: diag.Error,
-			Summary:  "Export the required INFOBLOX_PASSWORD environment variable to set the password.",
+			Summary:  err.Error(),
 		}}
 	}
 
-	seconds := int64(d.Get("connect_timeout").(int))
+	endpoints, err := buildEndpoints(d)
+	if err != nil {
+		return nil, diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  err.Error(),
+		}}
+	}
+	host, port, _ := net.SplitHostPort(endpoints[0])
 	hostConfig := ibclient.HostConfig{
-		Host:    d.Get("server").(string),
-		Port:    d.Get("port").(string),
+		Host:    host,
+		Port:    port,
 		Version: d.Get("wapi_version").(string),
 	}
 
-	authConfig := ibclient.AuthConfig{
-		Username: d.Get("username").(string),
-		Password: d.Get("password").(string),
+	transportConfig, err := buildTransportConfig(d)
+	if err != nil {
+		return nil, diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  err.Error(),
+		}}
 	}
 
-	transportConfig := ibclient.TransportConfig{
-		SslVerify:           d.Get("sslmode").(bool),
-		HttpRequestTimeout:  time.Duration(seconds),
-		HttpPoolConnections: d.Get("pool_connections").(int),
+	retryWaitMin := time.Duration(d.Get("retry_wait_min").(int)) * time.Second
+	retryWaitMax := time.Duration(d.Get("retry_wait_max").(int)) * time.Second
+	if retryWaitMax < retryWaitMin {
+		return nil, diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "'retry_wait_max' must not be less than 'retry_wait_min'",
+		}}
 	}
 
 	requestBuilder := &ibclient.WapiRequestBuilder{}
-	requestor := &ibclient.WapiHttpRequestor{}
+	// Every retry attempt is subject to the rate limits as well.
+	var requestor ibclient.HttpRequestor = &wapiErrorRequestor{HttpRequestor: &ibclient.WapiHttpRequestor{}}
+	requestor = newRateLimitingRequestor(
+		requestor, d.Get("max_concurrent_requests").(int), d.Get("requests_per_second").(float64))
+	if len(endpoints) > 1 {
+		requestor = newFailoverRequestor(requestor, endpoints)
+	}
+	requestor = newRetryingRequestor(
+		requestor, d.Get("max_retries").(int), retryWaitMin, retryWaitMax)
 
-	// TODO: reconsider. For the case when there is a need to keep more data than just a go-client's Connector.
 	conn, err := ibclient.NewConnector(hostConfig, authConfig, transportConfig, requestBuilder, requestor)
 	if err != nil {
 		return nil, diag.Diagnostics{diag.Diagnostic{Summary: err.Error()}}
 	}
+	providerConn := &providerConnector{
+		IBConnector: conn,
+		hostConfig:  hostConfig,
+		authConfig:  authConfig,
+		requestor:   requestor,
+		eaDefs:      &eaDefinitionCache{},
+	}
 
 	// Check and Create Pre-requisites
-	err = checkAndCreatePreRequisites(conn)
+	err = checkAndCreatePreRequisites(providerConn.withContext(ctx, nil))
 	if err != nil {
 		return nil, diag.Diagnostics{diag.Diagnostic{Summary: err.Error()}}
 	}
-	return conn, nil
+
+	defaultEAs, err := terraformDeserializeEAs(d.Get("default_ext_attrs").(string))
+	if err != nil {
+		return nil, diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("cannot process 'default_ext_attrs' field: %s", err),
+		}}
+	}
+	if _, found := defaultEAs[eaNameForInternalId]; found {
+		return nil, diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("'%s' extensible attribute must not be set in 'default_ext_attrs'", eaNameForInternalId),
+		}}
+	}
+
+	var ignoredEAs []string
+	for _, name := range d.Get("ignore_ext_attrs").([]interface{}) {
+		if name == nil || name.(string) == "" || name.(string) == "*" {
+			return nil, diag.Diagnostics{diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "'ignore_ext_attrs' must contain names or prefixes of extensible attributes",
+			}}
+		}
+		ignoredEAs = append(ignoredEAs, name.(string))
+	}
+
+	providerConn.defaultEAs = defaultEAs
+	providerConn.ignoredEAs = ignoredEAs
+
+	return providerConn, nil
+}
+
+// buildEndpoints returns the list of WAPI endpoints, in the form 'host:port', to try in order.
+func buildEndpoints(d *schema.ResourceData) ([]string, error) {
+	var servers []string
+	if list := d.Get("servers").([]interface{}); len(list) > 0 {
+		for _, server := range list {
+			if server == nil {
+				return nil, fmt.Errorf("'servers' must not contain empty values")
+			}
+			servers = append(servers, server.(string))
+		}
+	} else {
+		servers = strings.Split(d.Get("server").(string), ",")
+	}
+
+	port := d.Get("port").(string)
+	endpoints := make([]string, 0, len(servers))
+	for _, server := range servers {
+		server = strings.TrimSpace(server)
+		if server == "" {
+			continue
+		}
+		if host, serverPort, err := net.SplitHostPort(server); err == nil {
+			endpoints = append(endpoints, net.JoinHostPort(host, serverPort))
+		} else {
+			endpoints = append(endpoints, net.JoinHostPort(strings.Trim(server, "[]"), port))
+		}
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf(
+			"Export the required INFOBLOX_SERVER environment variable to set the server, or set 'servers'.")
+	}
+
+	return endpoints, nil
+}
+
+// buildAuthConfig returns the credentials for WAPI requests. A client certificate
+// may be used instead of a password, in which case the username is optional.
+func buildAuthConfig(d *schema.ResourceData) (ibclient.AuthConfig, error) {
+	authConfig := ibclient.AuthConfig{
+		Username: d.Get("username").(string),
+		Password: d.Get("password").(string),
+	}
+
+	clientCert := d.Get("client_cert").(string)
+	clientKey := d.Get("client_key").(string)
+	if clientCert == "" && clientKey == "" {
+		if authConfig.Username == "" {
+			return authConfig, fmt.Errorf(
+				"Export the required INFOBLOX_USERNAME environment variable to set the username, " +
+					"or use a client certificate.")
+		}
+		if authConfig.Password == "" {
+			return authConfig, fmt.Errorf(
+				"Export the required INFOBLOX_PASSWORD environment variable to set the password, " +
+					"or use a client certificate.")
+		}
+		return authConfig, nil
+	}
+	if clientCert == "" || clientKey == "" {
+		return authConfig, fmt.Errorf("both 'client_cert' and 'client_key' must be set to use a client certificate")
+	}
+
+	certPEM, err := readPEM(clientCert)
+	if err != nil {
+		return authConfig, fmt.Errorf("cannot read 'client_cert': %w", err)
+	}
+	keyPEM, err := readPEM(clientKey)
+	if err != nil {
+		return authConfig, fmt.Errorf("cannot read 'client_key': %w", err)
+	}
+
+	// The go-client terminates the process on an invalid key pair, so check it here first.
+	if _, err = tls.X509KeyPair(certPEM, keyPEM); err != nil {
+		return authConfig, fmt.Errorf("invalid client certificate key pair: %w", err)
+	}
+
+	authConfig.ClientCert = certPEM
+	authConfig.ClientKey = keyPEM
+
+	return authConfig, nil
+}
+
+// buildTransportConfig returns the TLS and proxy settings for the WAPI connection.
+func buildTransportConfig(d *schema.ResourceData) (ibclient.TransportConfig, error) {
+	seconds := d.Get("connect_timeout").(int)
+	poolConnections := d.Get("pool_connections").(int)
+
+	transportConfig := ibclient.TransportConfig{
+		SslVerify:           d.Get("sslmode").(bool),
+		HttpRequestTimeout:  time.Duration(seconds),
+		HttpPoolConnections: poolConnections,
+	}
+
+	if caCert := d.Get("ca_cert_file").(string); caCert != "" {
+		caPEM, err := readPEM(caCert)
+		if err != nil {
+			return transportConfig, fmt.Errorf("cannot read 'ca_cert_file': %w", err)
+		}
+		if !x509.NewCertPool().AppendCertsFromPEM(caPEM) {
+			return transportConfig, fmt.Errorf("'ca_cert_file' does not contain any PEM-encoded certificate")
+		}
+
+		// The go-client loads a CA bundle from a file only, so inline PEM content is passed through a temporary one.
+		caFile := caCert
+		if isPEMContent(caCert) {
+			tmpFile, err := os.CreateTemp("", "infoblox-ca-*.pem")
+			if err != nil {
+				return transportConfig, fmt.Errorf("cannot store 'ca_cert_file' content: %w", err)
+			}
+			defer os.Remove(tmpFile.Name())
+
+			_, err = tmpFile.Write(caPEM)
+			if closeErr := tmpFile.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return transportConfig, fmt.Errorf("cannot store 'ca_cert_file' content: %w", err)
+			}
+			caFile = tmpFile.Name()
+		}
+
+		transportConfig = ibclient.NewTransportConfig(caFile, seconds, poolConnections)
+	}
+
+	if proxyURL := d.Get("proxy_url").(string); proxyURL != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return transportConfig, fmt.Errorf("invalid 'proxy_url' value: %w", err)
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return transportConfig, fmt.Errorf(
+				"invalid 'proxy_url' value '%s': the scheme must be one of 'http', 'https' or 'socks5'", proxyURL)
+		}
+		if u.Host == "" {
+			return transportConfig, fmt.Errorf("invalid 'proxy_url' value '%s': the host is missing", proxyURL)
+		}
+		transportConfig.ProxyUrl = u
+	}
+
+	return transportConfig, nil
+}
+
+// readPEM accepts either PEM-encoded content or a path to a file containing it.
+func readPEM(value string) ([]byte, error) {
+	if isPEMContent(value) {
+		return []byte(value), nil
+	}
+
+	content, err := os.ReadFile(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+func isPEMContent(value string) bool {
+	return strings.Contains(value, "-----BEGIN ")
+}
+
+// diagsToError converts error diagnostics to an error, for functions which cannot return diagnostics (ex. importers).
+func diagsToError(diags diag.Diagnostics) error {
+	var msgs []string
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if d.Detail != "" {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", d.Summary, d.Detail))
+		} else {
+			msgs = append(msgs, d.Summary)
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s", strings.Join(msgs, "; "))
 }
 
 // filterFromMap generates filter map for NIOS query parameters from a terraform map[string]interface{}
@@ -310,6 +747,44 @@ func filterFromMap(filtersMap map[string]interface{}) map[string]string {
 	return filters
 }
 
+// stringPtrValue returns the value of an optional string field of a NIOS object, or an empty string if it is not set.
+func stringPtrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// uint32PtrValue returns the value of an optional number field of a NIOS object, or 0 if it is not set.
+func uint32PtrValue(v *uint32) int {
+	if v == nil {
+		return 0
+	}
+
+	return int(*v)
+}
+
+// convertInterfaceToStringSlice converts a list of strings of a resource's field.
+func convertInterfaceToStringSlice(list []interface{}) []string {
+	res := make([]string, len(list))
+	for i, v := range list {
+		res[i] = v.(string)
+	}
+	return res
+}
+
+// ttlFromResource returns the TTL of a record and whether it is used rather than the zone's one.
+func ttlFromResource(d *schema.ResourceData) (uint32, bool, error) {
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		return uint32(tempTTL), true, nil
+	} else if tempTTL != ttlUndef {
+		return 0, false, fmt.Errorf("TTL value must be 0 or higher")
+	}
+	return 0, false, nil
+}
+
 // terraformSerializeEAs will convert ibclient.EA to a JSON-formatted string,
 // which is generally used as a value for 'ext_attrs' terraform fields.
 func terraformSerializeEAs(ea ibclient.EA) (string, error) {
@@ -342,9 +817,11 @@ func terraformDeserializeEAs(extAttrJSON string) (map[string]interface{}, error)
 }
 
 // omitEAs will omit NIOS-side EAs that are not present on the terraform-provider side.
+// EAs ignored by the provider keep their terraform-side values, so they never cause a difference.
 // Should be used for read operations.
-func omitEAs(niosEAs, terraformEAs map[string]interface{}) map[string]interface{} {
-	// ToDo: When EA inheritance is implemented on the go-client side, only inherited EAs should be omitted here.
+func omitEAs(niosEAs, terraformEAs map[string]interface{}, m interface{}) map[string]interface{} {
+	// EAs not set by the resource, inherited ones among them, are omitted here; the resources which inherit EAs
+	// report the inherited ones in their 'inherited_ext_attrs' field.
 	res := niosEAs
 	for attrName, _ := range niosEAs {
 		if _, ok := terraformEAs[attrName]; !ok {
@@ -352,14 +829,30 @@ func omitEAs(niosEAs, terraformEAs map[string]interface{}) map[string]interface{
 		}
 	}
 
+	for attrName, tfVal := range terraformEAs {
+		if isIgnoredEA(attrName, m) {
+			if res == nil {
+				res = make(map[string]interface{})
+			}
+			res[attrName] = tfVal
+		}
+	}
+
 	return res
 }
 
-// mergeEAs merges omitted NIOS-side EAs with EAs specified in terraform configuration.
-// Should be used in update functions.
+// mergeEAs merges omitted NIOS-side EAs with EAs specified in terraform configuration
+// and the provider's default EAs. Should be used in update functions.
 func mergeEAs(niosEAs, newTerraformEAs, oldTerraformEAs map[string]interface{}, conn ibclient.IBConnector) (ibclient.EA, error) {
+	newTerraformEAs = withDefaultEAs(newTerraformEAs, conn)
 	res := map[string]interface{}{}
 	for key, niosVal := range niosEAs {
+		// EAs ignored by the provider are never changed or removed.
+		if isIgnoredEA(key, conn) {
+			res[key] = niosVal
+			continue
+		}
+
 		// If EA is present on the NIOS side, and there's no attempt to
 		// change a value of this EA by the terraform user, use EA value from NIOS
 
@@ -385,7 +878,7 @@ func mergeEAs(niosEAs, newTerraformEAs, oldTerraformEAs map[string]interface{},
 
 	// Merge EAs, added to the terraform configuration
 	for key, newTfVal := range newTerraformEAs {
-		if _, ok := res[key]; !ok {
+		if _, ok := res[key]; !ok && !isIgnoredEA(key, conn) {
 			res[key] = newTfVal
 		}
 	}
@@ -393,27 +886,16 @@ func mergeEAs(niosEAs, newTerraformEAs, oldTerraformEAs map[string]interface{},
 	return res, nil
 }
 
+// checkEARequirement checks whether the extensible attribute is mandatory.
+// An attribute which is not defined, or whose definition cannot be read, is not.
 func checkEARequirement(name string, conn ibclient.IBConnector) bool {
-	eadef := &ibclient.EADefinition{}
-	eadef.SetReturnFields(append(eadef.ReturnFields(), "flags"))
-
-	sf := map[string]string{
-		"name": name,
-	}
-	qp := ibclient.NewQueryParams(false, sf)
-	var res []ibclient.EADefinition
-
-	err := conn.GetObject(eadef, "", qp, &res)
+	defs, err := eaDefinitions(conn)
 	if err != nil {
-		fmt.Errorf("failed to get EA definition")
-	}
-	result := &res[0]
-	if result.Flags != nil {
-		if strings.Contains(*result.Flags, "M") {
-			return true
-		}
+		return false
 	}
-	return false
+	eadef, found := defs[name]
+
+	return found && hasEAFlag(eadef, "M")
 }
 
 // Check Pre-requisites for the provider and create if not present
@@ -469,8 +951,7 @@ func searchObjectByRefOrInternalId(objType string, d *schema.ResourceData, m int
 		}
 	}
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -485,6 +966,58 @@ func searchObjectByRefOrInternalId(objType string, d *schema.ResourceData, m int
 	return objMgr.SearchObjectByAltId(objType, ref, actualIntId.String(), eaNameForInternalId)
 }
 
+// getObjectByRefOrInternalId is the same as searchObjectByRefOrInternalId, for the object types, which the go-client
+// cannot search by itself, i.e. the ones missing in its record type map, ex. the NAPTR, DNAME and TLSA records.
+// obj is an empty object of the type, with its return fields, the object found is unmarshalled to res.
+func getObjectByRefOrInternalId(obj ibclient.IBObject, d *schema.ResourceData, m interface{}, res interface{}) error {
+	var ref, internalId string
+	if r, found := d.GetOk("ref"); found {
+		ref = r.(string)
+	} else {
+		_, ref = getAltIdFields(d.Id())
+	}
+	if id, found := d.GetOk("internal_id"); found {
+		internalId = id.(string)
+	}
+
+	conn := m.(ibclient.IBConnector)
+	if ref != "" {
+		var rec json.RawMessage
+		err := conn.GetObject(obj, ref, ibclient.NewQueryParams(false, nil), &rec)
+		if err != nil {
+			if _, ok := err.(*ibclient.NotFoundError); !ok {
+				return err
+			}
+		} else {
+			var found struct {
+				Ea ibclient.EA `json:"extattrs"`
+			}
+			if err = json.Unmarshal(rec, &found); err != nil {
+				return err
+			}
+			if internalId == "" || found.Ea[eaNameForInternalId] == internalId {
+				return json.Unmarshal(rec, res)
+			}
+		}
+	}
+	if internalId == "" {
+		return ibclient.NewNotFoundError(fmt.Sprintf("object with reference '%s' is not found", ref))
+	}
+
+	var recs []json.RawMessage
+	sf := map[string]string{
+		fmt.Sprintf("*%s", eaNameForInternalId): internalId,
+	}
+	if err := conn.GetObject(obj, "", ibclient.NewQueryParams(false, sf), &recs); err != nil {
+		return err
+	}
+	if len(recs) == 0 {
+		return ibclient.NewNotFoundError(fmt.Sprintf("object with internal ID '%s' is not found", internalId))
+	}
+
+	return json.Unmarshal(recs[0], res)
+}
+
 func CompareSortedList(oldList interface{}, newList interface{}, key1 string, key2 string) bool {
 	oldListSlice, okOld := oldList.([]interface{})
 	newListSlice, okNew := newList.([]interface{})
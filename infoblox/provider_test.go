@@ -1,9 +1,19 @@
 package infoblox
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"os"
+	"path/filepath"
+	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -79,3 +89,192 @@ func runTestCases(t *testing.T, cases []testCase) {
 		}
 	}
 }
+
+func testGenerateClientCertificate(t *testing.T) (certPEM, keyPEM string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return
+}
+
+func TestProviderAuthConfig(t *testing.T) {
+	for _, env := range []string{
+		"INFOBLOX_USERNAME", "INFOBLOX_PASSWORD", "INFOBLOX_CLIENT_CERT", "INFOBLOX_CLIENT_KEY"} {
+		t.Setenv(env, "")
+	}
+
+	certPEM, keyPEM := testGenerateClientCertificate(t)
+	certFile := filepath.Join(t.TempDir(), "client.pem")
+	if err := os.WriteFile(certFile, []byte(certPEM), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		raw         map[string]interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			raw:         map[string]interface{}{"username": "admin"},
+			expectedErr: regexp.MustCompile("INFOBLOX_PASSWORD"),
+		},
+		{
+			raw: map[string]interface{}{"username": "admin", "password": "infoblox"},
+		},
+		{
+			raw:         map[string]interface{}{"client_cert": certPEM},
+			expectedErr: regexp.MustCompile("both 'client_cert' and 'client_key' must be set"),
+		},
+		{
+			raw:         map[string]interface{}{"client_cert": certFile, "client_key": certPEM},
+			expectedErr: regexp.MustCompile("invalid client certificate key pair"),
+		},
+		{
+			raw:         map[string]interface{}{"client_cert": "/nonexistent/client.pem", "client_key": keyPEM},
+			expectedErr: regexp.MustCompile("cannot read 'client_cert'"),
+		},
+		{
+			raw: map[string]interface{}{"client_cert": certFile, "client_key": keyPEM},
+		},
+	}
+
+	for i, tc := range cases {
+		d := schema.TestResourceDataRaw(t, Provider().Schema, tc.raw)
+		authConfig, err := buildAuthConfig(d)
+		if tc.expectedErr == nil {
+			if err != nil {
+				t.Fatalf("expected test case %d to produce no errors, got %v", i, err)
+			}
+			if _, found := tc.raw["client_cert"]; found && len(authConfig.ClientCert) == 0 {
+				t.Fatalf("expected test case %d to set the client certificate", i)
+			}
+			continue
+		}
+		if err == nil || !tc.expectedErr.MatchString(err.Error()) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, err)
+		}
+	}
+}
+
+func TestProviderTransportConfig(t *testing.T) {
+	for _, env := range []string{"INFOBLOX_CA_CERT_FILE", "INFOBLOX_PROXY_URL"} {
+		t.Setenv(env, "")
+	}
+
+	caPEM, _ := testGenerateClientCertificate(t)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(caPEM), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		raw         map[string]interface{}
+		sslVerify   bool
+		proxyURL    string
+		expectedErr *regexp.Regexp
+	}{
+		{
+			raw: map[string]interface{}{"sslmode": false},
+		},
+		{
+			raw:       map[string]interface{}{"sslmode": false, "ca_cert_file": caFile},
+			sslVerify: true,
+		},
+		{
+			raw:       map[string]interface{}{"ca_cert_file": caPEM},
+			sslVerify: true,
+		},
+		{
+			raw:         map[string]interface{}{"ca_cert_file": "-----BEGIN CERTIFICATE-----\nbroken\n"},
+			expectedErr: regexp.MustCompile("does not contain any PEM-encoded certificate"),
+		},
+		{
+			raw:      map[string]interface{}{"proxy_url": "http://proxy.example.com:3128"},
+			proxyURL: "http://proxy.example.com:3128",
+		},
+		{
+			raw:         map[string]interface{}{"proxy_url": "proxy.example.com:3128"},
+			expectedErr: regexp.MustCompile("invalid 'proxy_url' value"),
+		},
+	}
+
+	for i, tc := range cases {
+		d := schema.TestResourceDataRaw(t, Provider().Schema, tc.raw)
+		transportConfig, err := buildTransportConfig(d)
+		if tc.expectedErr != nil {
+			if err == nil || !tc.expectedErr.MatchString(err.Error()) {
+				t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, err)
+		}
+		if transportConfig.SslVerify != tc.sslVerify {
+			t.Fatalf("test case %d: expected SSL verification to be %t", i, tc.sslVerify)
+		}
+		if tc.proxyURL != "" && (transportConfig.ProxyUrl == nil || transportConfig.ProxyUrl.String() != tc.proxyURL) {
+			t.Fatalf("test case %d: expected proxy URL '%s', got '%v'", i, tc.proxyURL, transportConfig.ProxyUrl)
+		}
+	}
+}
+
+func TestProviderEndpoints(t *testing.T) {
+	t.Setenv("INFOBLOX_SERVER", "")
+	t.Setenv("PORT", "")
+
+	cases := []struct {
+		raw       map[string]interface{}
+		endpoints []string
+	}{
+		{
+			raw:       map[string]interface{}{"server": "gm.example.com"},
+			endpoints: []string{"gm.example.com:443"},
+		},
+		{
+			raw:       map[string]interface{}{"server": "gm1.example.com, gm2.example.com:8443,", "port": "4443"},
+			endpoints: []string{"gm1.example.com:4443", "gm2.example.com:8443"},
+		},
+		{
+			raw:       map[string]interface{}{"servers": []interface{}{"10.0.0.1", "[2001:db8::1]:443", "2001:db8::2"}},
+			endpoints: []string{"10.0.0.1:443", "[2001:db8::1]:443", "[2001:db8::2]:443"},
+		},
+		{
+			raw: map[string]interface{}{},
+		},
+	}
+
+	for i, tc := range cases {
+		d := schema.TestResourceDataRaw(t, Provider().Schema, tc.raw)
+		endpoints, err := buildEndpoints(d)
+		if tc.endpoints == nil {
+			if err == nil {
+				t.Fatalf("expected test case %d to fail", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, err)
+		}
+		if !reflect.DeepEqual(endpoints, tc.endpoints) {
+			t.Fatalf("test case %d: expected endpoints %v, got %v", i, tc.endpoints, endpoints)
+		}
+	}
+}
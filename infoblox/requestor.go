@@ -0,0 +1,380 @@
+package infoblox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// NIOS rejects a request with this kind of message while the database is locked by another operation,
+// the request is not processed in that case.
+var wapiDatabaseBusyRegExp = regexp.MustCompile(`(?i)database (is )?(busy|locked)`)
+
+// wapiErrorStatusCode returns the HTTP status code of an unsuccessful WAPI response, or 0 if the error
+// is not caused by a WAPI response (ex. a transport error).
+func wapiErrorStatusCode(err error) int {
+	if isNotFoundError(err) {
+		return http.StatusNotFound
+	}
+
+	var wapiErr *wapiError
+	if errors.As(err, &wapiErr) || errors.As(newWapiError(err), &wapiErr) {
+		return wapiErr.StatusCode
+	}
+
+	return 0
+}
+
+// isRetryableRequestError checks whether a request which failed with the given error may be safely sent once again.
+// GET requests are idempotent and are retried on any transient failure. Other requests are retried only when
+// it is known that NIOS has not processed them.
+func isRetryableRequestError(method string, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	statusCode := wapiErrorStatusCode(err)
+	if statusCode == 0 {
+		if method == http.MethodGet {
+			return true
+		}
+
+		// The connection was not established, so the request has not been sent.
+		var opErr *net.OpError
+		return errors.As(err, &opErr) && opErr.Op == "dial"
+	}
+
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return method == http.MethodGet
+	}
+
+	msg := err.Error()
+	var wapiErr *wapiError
+	if errors.As(err, &wapiErr) {
+		msg += "\n" + wapiErr.Message
+	}
+
+	return wapiDatabaseBusyRegExp.MatchString(msg)
+}
+
+// contextRequestor sends WAPI requests within the context of a terraform operation.
+type contextRequestor struct {
+	ibclient.HttpRequestor
+
+	ctx context.Context
+
+	// Schema of the resource which sends the requests, to find the attributes which have caused WAPI errors.
+	resourceSchema map[string]*schema.Schema
+}
+
+// Init does nothing, as the underlying requestor is shared by all the operations and has been initialized already.
+func (r *contextRequestor) Init(ibclient.AuthConfig, ibclient.TransportConfig) {}
+
+func (r *contextRequestor) SendRequest(req *http.Request) ([]byte, error) {
+	res, err := r.HttpRequestor.SendRequest(req.WithContext(r.ctx))
+	if err != nil {
+		var wapiErr *wapiError
+		if errors.As(err, &wapiErr) {
+			wapiErr.attrPath = wapiErr.attributePath(r.resourceSchema)
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// retryingRequestor retries WAPI requests which failed because of transient errors,
+// such as a grid master failover or a busy database, with an exponential backoff.
+type retryingRequestor struct {
+	ibclient.HttpRequestor
+
+	maxRetries   int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+}
+
+func newRetryingRequestor(
+	requestor ibclient.HttpRequestor,
+	maxRetries int,
+	retryWaitMin time.Duration,
+	retryWaitMax time.Duration) *retryingRequestor {
+
+	return &retryingRequestor{
+		HttpRequestor: requestor,
+		maxRetries:    maxRetries,
+		retryWaitMin:  retryWaitMin,
+		retryWaitMax:  retryWaitMax,
+	}
+}
+
+// backoff returns the time to wait before the given retry attempt, starting from 1.
+func (r *retryingRequestor) backoff(attempt int) time.Duration {
+	wait := r.retryWaitMin
+	for i := 1; i < attempt && wait < r.retryWaitMax; i++ {
+		wait *= 2
+	}
+	if wait > r.retryWaitMax {
+		wait = r.retryWaitMax
+	}
+
+	return wait
+}
+
+func (r *retryingRequestor) SendRequest(req *http.Request) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("cannot rewind the request body for a retry: %w", err)
+			}
+			req.Body = body
+		}
+
+		res, err := r.HttpRequestor.SendRequest(req)
+		if err == nil {
+			return res, nil
+		}
+		if attempt >= r.maxRetries || !isRetryableRequestError(req.Method, err) {
+			return nil, err
+		}
+
+		wait := r.backoff(attempt + 1)
+		log.Warn(req.Context(), "retrying WAPI request after a transient error", map[string]interface{}{
+			"method":      req.Method,
+			"url":         req.URL.Redacted(),
+			"attempt":     attempt + 1,
+			"max_retries": r.maxRetries,
+			"wait":        wait.String(),
+			"error":       err.Error(),
+		})
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// tokenBucket limits the rate of events to 'rate' per second, allowing bursts of up to 'burst' events.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := math.Max(1, math.Ceil(rate))
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token from the bucket and returns the time to wait until the token becomes available.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a token, which was reserved but not used, to the bucket.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
+
+// wait blocks until a token is available or the context is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	delay := b.reserve()
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitingRequestor caps the number of WAPI requests in flight and the rate at which they are sent.
+// A single instance is shared by all resources and data sources of a provider instance.
+type rateLimitingRequestor struct {
+	ibclient.HttpRequestor
+
+	slots   chan struct{}
+	limiter *tokenBucket
+}
+
+// newRateLimitingRequestor returns a requestor which allows at most maxConcurrentRequests requests in flight
+// and requestsPerSecond requests per second; zero means no limit for either of them.
+func newRateLimitingRequestor(
+	requestor ibclient.HttpRequestor,
+	maxConcurrentRequests int,
+	requestsPerSecond float64) *rateLimitingRequestor {
+
+	r := &rateLimitingRequestor{HttpRequestor: requestor}
+	if maxConcurrentRequests > 0 {
+		r.slots = make(chan struct{}, maxConcurrentRequests)
+	}
+	if requestsPerSecond > 0 {
+		r.limiter = newTokenBucket(requestsPerSecond)
+	}
+
+	return r
+}
+
+func (r *rateLimitingRequestor) SendRequest(req *http.Request) ([]byte, error) {
+	ctx := req.Context()
+
+	if r.slots != nil {
+		select {
+		case r.slots <- struct{}{}:
+			defer func() { <-r.slots }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if r.limiter != nil {
+		if err := r.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.HttpRequestor.SendRequest(req)
+}
+
+// endpointError annotates an error with the WAPI endpoint which served the failed request.
+type endpointError struct {
+	endpoint string
+	err      error
+}
+
+func (e *endpointError) Error() string {
+	return fmt.Sprintf("%s [WAPI endpoint: %s]", e.err.Error(), e.endpoint)
+}
+
+func (e *endpointError) Unwrap() error {
+	return e.err
+}
+
+// isEndpointUnavailable checks whether a request failed because the WAPI endpoint is not able to serve requests,
+// ex. during a grid master failover.
+func isEndpointUnavailable(err error) bool {
+	switch wapiErrorStatusCode(err) {
+	case 0, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// failoverRequestor sends WAPI requests to the first available endpoint out of a list,
+// starting with the one which served the last request successfully.
+type failoverRequestor struct {
+	ibclient.HttpRequestor
+
+	endpoints []string
+	mu        sync.Mutex
+	healthy   int
+}
+
+// newFailoverRequestor returns a requestor for the given endpoints, each of them in the form 'host:port'.
+func newFailoverRequestor(requestor ibclient.HttpRequestor, endpoints []string) *failoverRequestor {
+	return &failoverRequestor{
+		HttpRequestor: requestor,
+		endpoints:     endpoints,
+	}
+}
+
+func (r *failoverRequestor) healthyEndpoint() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.healthy
+}
+
+func (r *failoverRequestor) setHealthyEndpoint(idx int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.healthy = idx
+}
+
+func (r *failoverRequestor) SendRequest(req *http.Request) ([]byte, error) {
+	start := r.healthyEndpoint()
+	for i := 0; ; i++ {
+		idx := (start + i) % len(r.endpoints)
+		endpoint := r.endpoints[idx]
+
+		if i > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("cannot rewind the request body for another endpoint: %w", err)
+			}
+			req.Body = body
+		}
+		req.URL.Host = endpoint
+		req.Host = endpoint
+
+		res, err := r.HttpRequestor.SendRequest(req)
+		if err == nil {
+			if idx != start {
+				r.setHealthyEndpoint(idx)
+			}
+			return res, nil
+		}
+
+		// The object does not exist, so there is nothing wrong with the endpoint.
+		if isNotFoundError(err) {
+			return nil, err
+		}
+
+		if i == len(r.endpoints)-1 || !isEndpointUnavailable(err) || !isRetryableRequestError(req.Method, err) {
+			return nil, &endpointError{endpoint: endpoint, err: err}
+		}
+
+		log.Warn(req.Context(), "WAPI endpoint is unavailable, trying the next one", map[string]interface{}{
+			"method":   req.Method,
+			"endpoint": endpoint,
+			"next":     r.endpoints[(idx+1)%len(r.endpoints)],
+			"error":    err.Error(),
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package infoblox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// testRequestor replies to requests with the given errors, one per request, and succeeds afterwards.
+type testRequestor struct {
+	errs   []error
+	calls  int
+	bodies []string
+}
+
+func (tr *testRequestor) Init(ibclient.AuthConfig, ibclient.TransportConfig) {}
+
+func (tr *testRequestor) SendRequest(req *http.Request) ([]byte, error) {
+	tr.calls++
+	if req.Body != nil {
+		body, _ := io.ReadAll(req.Body)
+		tr.bodies = append(tr.bodies, string(body))
+	}
+	if len(tr.errs) > 0 {
+		err := tr.errs[0]
+		tr.errs = tr.errs[1:]
+		return nil, err
+	}
+	return []byte(`"ok"`), nil
+}
+
+func testWapiError(statusCode int, contents string) error {
+	return fmt.Errorf("WAPI request error: %d('%d %s')\nContents:\n%s\n",
+		statusCode, statusCode, http.StatusText(statusCode), contents)
+}
+
+func TestRetryingRequestor(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	resetErr := &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+	busyErr := testWapiError(http.StatusBadRequest,
+		`{"Error": "AdmConDataError: None (IBDataConflictError: IB.Data.Conflict:The database is busy)"}`)
+
+	cases := []struct {
+		method        string
+		errs          []error
+		maxRetries    int
+		expectedCalls int
+		expectSuccess bool
+	}{
+		{http.MethodGet, []error{testWapiError(http.StatusBadGateway, ""), resetErr}, 3, 3, true},
+		{http.MethodGet, []error{resetErr, resetErr, resetErr}, 2, 3, false},
+		{http.MethodGet, []error{ibclient.NewNotFoundError("not found")}, 3, 1, false},
+		{http.MethodGet, []error{testWapiError(http.StatusBadRequest, `{"Error": "AdmConProtoError"}`)}, 3, 1, false},
+		{http.MethodPost, []error{resetErr}, 3, 1, false},
+		{http.MethodPost, []error{testWapiError(http.StatusBadGateway, "")}, 3, 1, false},
+		{http.MethodPost, []error{dialErr, testWapiError(http.StatusServiceUnavailable, "")}, 3, 3, true},
+		{http.MethodPut, []error{busyErr}, 3, 2, true},
+		{http.MethodDelete, []error{testWapiError(http.StatusTooManyRequests, "")}, 0, 1, false},
+	}
+
+	for i, tc := range cases {
+		inner := &testRequestor{errs: tc.errs}
+		requestor := newRetryingRequestor(inner, tc.maxRetries, 0, 0)
+
+		req, err := http.NewRequest(tc.method, "https://nios.example.com/wapi/v2.12.3/record:a", bytes.NewBufferString(`{"name":"a"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = requestor.SendRequest(req)
+		if tc.expectSuccess && err != nil {
+			t.Fatalf("expected test case %d to succeed, got %v", i, err)
+		}
+		if !tc.expectSuccess && err == nil {
+			t.Fatalf("expected test case %d to fail", i)
+		}
+		if inner.calls != tc.expectedCalls {
+			t.Fatalf("expected test case %d to send %d requests, got %d", i, tc.expectedCalls, inner.calls)
+		}
+		for _, body := range inner.bodies {
+			if body != `{"name":"a"}` {
+				t.Fatalf("test case %d: request body was not rewound for a retry, got '%s'", i, body)
+			}
+		}
+	}
+}
+
+func TestRetryingRequestorBackoff(t *testing.T) {
+	requestor := newRetryingRequestor(&testRequestor{}, 5, time.Second, 5*time.Second)
+
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, wait := range expected {
+		if actual := requestor.backoff(i + 1); actual != wait {
+			t.Fatalf("expected backoff for attempt %d to be %s, got %s", i+1, wait, actual)
+		}
+	}
+}
+
+func TestRetryingRequestorCancel(t *testing.T) {
+	inner := &testRequestor{errs: []error{testWapiError(http.StatusServiceUnavailable, "")}}
+	requestor := newRetryingRequestor(inner, 3, time.Hour, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://nios.example.com/wapi/v2.12.3/record:a", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	if _, err = requestor.SendRequest(req); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the request to be cancelled, got %v", err)
+	}
+	if inner.calls != 1 {
+		t.Fatalf("expected 1 request to be sent, got %d", inner.calls)
+	}
+}
+
+// testBlockingRequestor keeps every request in flight for the given time and tracks the peak number of them.
+type testBlockingRequestor struct {
+	mu       sync.Mutex
+	delay    time.Duration
+	inFlight int
+	peak     int
+}
+
+func (tr *testBlockingRequestor) Init(ibclient.AuthConfig, ibclient.TransportConfig) {}
+
+func (tr *testBlockingRequestor) SendRequest(*http.Request) ([]byte, error) {
+	tr.mu.Lock()
+	tr.inFlight++
+	if tr.inFlight > tr.peak {
+		tr.peak = tr.inFlight
+	}
+	tr.mu.Unlock()
+
+	time.Sleep(tr.delay)
+
+	tr.mu.Lock()
+	tr.inFlight--
+	tr.mu.Unlock()
+	return []byte(`"ok"`), nil
+}
+
+func TestRateLimitingRequestorConcurrency(t *testing.T) {
+	inner := &testBlockingRequestor{delay: 20 * time.Millisecond}
+	requestor := newRateLimitingRequestor(inner, 2, 0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "https://nios.example.com/wapi/v2.12.3/record:a", nil)
+			if _, err := requestor.SendRequest(req); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if inner.peak > 2 {
+		t.Fatalf("expected at most 2 requests in flight, got %d", inner.peak)
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	bucket := newTokenBucket(2)
+
+	// The burst allows two requests right away, the next ones are spaced out by half a second.
+	expected := []time.Duration{0, 0, 500 * time.Millisecond, time.Second}
+	for i, wait := range expected {
+		actual := bucket.reserve()
+		if actual < wait-50*time.Millisecond || actual > wait {
+			t.Fatalf("expected reservation %d to wait about %s, got %s", i, wait, actual)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := bucket.wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the wait to be cancelled, got %v", err)
+	}
+}
+
+// testEndpointRequestor fails requests to the given endpoints with the given errors and records the endpoints used.
+type testEndpointRequestor struct {
+	errs      map[string]error
+	endpoints []string
+}
+
+func (tr *testEndpointRequestor) Init(ibclient.AuthConfig, ibclient.TransportConfig) {}
+
+func (tr *testEndpointRequestor) SendRequest(req *http.Request) ([]byte, error) {
+	tr.endpoints = append(tr.endpoints, req.URL.Host)
+	if err, found := tr.errs[req.URL.Host]; found {
+		return nil, err
+	}
+	return []byte(`"ok"`), nil
+}
+
+func TestFailoverRequestor(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	inner := &testEndpointRequestor{errs: map[string]error{
+		"gm1.example.com:443": dialErr,
+		"gm2.example.com:443": testWapiError(http.StatusServiceUnavailable, ""),
+	}}
+	requestor := newFailoverRequestor(inner,
+		[]string{"gm1.example.com:443", "gm2.example.com:443", "gm3.example.com:443"})
+
+	send := func(method string) error {
+		req, err := http.NewRequest(method, "https://gm1.example.com:443/wapi/v2.12.3/record:a", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = requestor.SendRequest(req)
+		return err
+	}
+
+	if err := send(http.MethodPost); err != nil {
+		t.Fatalf("expected the request to succeed on the third endpoint, got %v", err)
+	}
+	if len(inner.endpoints) != 3 || inner.endpoints[2] != "gm3.example.com:443" {
+		t.Fatalf("expected all the endpoints to be tried in order, got %v", inner.endpoints)
+	}
+
+	// The healthy endpoint is remembered for the next requests.
+	inner.endpoints = nil
+	if err := send(http.MethodGet); err != nil {
+		t.Fatal(err)
+	}
+	if len(inner.endpoints) != 1 || inner.endpoints[0] != "gm3.example.com:443" {
+		t.Fatalf("expected the request to be sent to the healthy endpoint, got %v", inner.endpoints)
+	}
+
+	// A request rejected by NIOS is not sent to another endpoint, and the error names the endpoint.
+	inner.errs["gm3.example.com:443"] = testWapiError(http.StatusBadRequest, `{"Error": "AdmConDataError"}`)
+	inner.endpoints = nil
+	err := send(http.MethodPut)
+	if err == nil || !strings.Contains(err.Error(), "[WAPI endpoint: gm3.example.com:443]") {
+		t.Fatalf("expected the error to name the endpoint, got %v", err)
+	}
+	if len(inner.endpoints) != 1 {
+		t.Fatalf("expected the request not to be sent to another endpoint, got %v", inner.endpoints)
+	}
+
+	// Not found errors are returned as is.
+	inner.errs["gm3.example.com:443"] = ibclient.NewNotFoundError("not found")
+	if err = send(http.MethodGet); !isNotFoundError(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
@@ -4,19 +4,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func resourceARecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceARecordCreate,
-		Read:   resourceARecordGet,
-		Update: resourceARecordUpdate,
-		Delete: resourceARecordDelete,
+		CreateContext: resourceARecordCreate,
+		ReadContext:   resourceARecordGet,
+		UpdateContext: resourceARecordUpdate,
+		DeleteContext: resourceARecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceARecordImport,
+			StateContext: resourceARecordImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -80,6 +82,7 @@ func resourceARecord() *schema.Resource {
 				Default:     "",
 				Description: "Extensible attributes of the A-record to be added/updated, as a map in JSON format",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -96,10 +99,10 @@ func resourceARecord() *schema.Resource {
 	}
 }
 
-func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourceARecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	networkView := d.Get("network_view").(string)
@@ -112,11 +115,11 @@ func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 	ipAddr := d.Get("ip_addr").(string)
 	nextAvailableFilter := d.Get("filter_params").(string)
 	if ipAddr == "" && cidr == "" && nextAvailableFilter == "" {
-		return fmt.Errorf("either of 'ip_addr' or 'cidr' or 'filter_params' values is required")
+		return diagFromErr(fmt.Errorf("either of 'ip_addr' or 'cidr' or 'filter_params' values is required"))
 	}
 
 	if ipAddr != "" && cidr != "" && nextAvailableFilter == "" {
-		return fmt.Errorf("only one of 'ip_addr' or 'cidr' or 'filter_params' values is allowed to be defined")
+		return diagFromErr(fmt.Errorf("only one of 'ip_addr' or 'cidr' or 'filter_params' values is allowed to be defined"))
 	}
 
 	var ttl uint32
@@ -127,17 +130,18 @@ func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	// Generate internal ID and add it to the extensible attributes
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -158,17 +162,17 @@ func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 		err = json.Unmarshal([]byte(nextAvailableFilter), &eaMap)
 		eaMap["network_view"] = networkView
 		if err != nil {
-			return fmt.Errorf("error unmarshalling extra attributes of network container: %s", err)
+			return diagFromErr(fmt.Errorf("error unmarshalling extra attributes of network container: %w", err))
 		}
 		rec, err := objMgr.AllocateNextAvailableIp(fqdn, "record:a", eaMap, nil, false, extAttrs, comment, false, nil, "IPV4",
 			false, false, "", "", networkView, dnsViewName, useTtl, ttl, nil)
 		if err != nil {
-			return fmt.Errorf("error allocating next available IP: %w", err)
+			return diagFromErr(fmt.Errorf("error allocating next available IP: %w", err))
 		}
 		var ok bool
 		newRecord, ok = rec.(*ibclient.RecordA)
 		if !ok {
-			return fmt.Errorf("failed to convert rec to *ibclient.RecordA")
+			return diagFromErr(fmt.Errorf("failed to convert rec to *ibclient.RecordA"))
 		}
 	} else {
 		newRecord, err = objMgr.CreateARecord(
@@ -182,40 +186,39 @@ func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 			comment,
 			extAttrs)
 		if err != nil {
-			return fmt.Errorf("creation of A-record under DNS view '%s' failed: %w", dnsViewName, err)
+			return diagFromErr(fmt.Errorf("creation of A-record under DNS view '%s' failed: %w", dnsViewName, err))
 		}
 	}
 	d.SetId(newRecord.Ref)
 	if err = d.Set("ref", newRecord.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ip_addr", newRecord.Ipv4Addr); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if val, ok := d.GetOk("network_view"); !ok || val.(string) == "" {
 		dnsViewObj, err := objMgr.GetDNSView(dnsViewName)
 		if err != nil {
-			return fmt.Errorf(
+			return diagFromErr(fmt.Errorf(
 				"error while retrieving information about DNS view '%s': %w",
-				dnsViewName, err)
+				dnsViewName, err))
 		}
 		if err = d.Set("network_view", dnsViewObj.NetworkView); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceARecordGet(d *schema.ResourceData, m interface{}) error {
+func resourceARecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	connector := m.(ibclient.IBConnector)
@@ -228,8 +231,8 @@ func resourceARecordGet(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		} else {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		}
 	}
 
@@ -239,11 +242,11 @@ func resourceARecordGet(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &recA)
 
 	if err != nil {
-		return fmt.Errorf("failed getting A-record: %w", err)
+		return diagFromErr(fmt.Errorf("failed getting A-record: %w", err))
 	}
 
 	if err = d.Set("ip_addr", recA.Ipv4Addr); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if recA.Ttl != nil {
@@ -253,45 +256,45 @@ func resourceARecordGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	delete(recA.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(recA.Ea, extAttrs)
+	omittedEAs := omitEAs(recA.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("comment", recA.Comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("dns_view", recA.View); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", recA.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if val, ok := d.GetOk("network_view"); !ok || val.(string) == "" {
 		dnsView, err := objMgr.GetDNSView(recA.View)
 		if err != nil {
-			return fmt.Errorf(
+			return diagFromErr(fmt.Errorf(
 				"error while retrieving information about DNS view '%s': %w",
-				recA.View, err)
+				recA.View, err))
 		}
 		if err = d.Set("network_view", dnsView.NetworkView); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("fqdn", recA.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(recA.Ref)
@@ -299,7 +302,7 @@ func resourceARecordGet(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceARecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -314,6 +317,7 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 			prevNextAvailableFilter, _ := d.GetChange("filter_params")
 
 			// TODO: move to the new Terraform plugin framework and
@@ -326,22 +330,23 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 			_ = d.Set("filter_params", prevNextAvailableFilter.(string))
 		}
 	}()
 
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'network_view' field is not allowed"))
 	}
 
 	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
 	}
 	if d.HasChange("filter_params") {
-		return fmt.Errorf("changing the value of 'filter_params' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'filter_params' field is not allowed"))
 	}
 
 	networkView := d.Get("network_view").(string)
@@ -367,7 +372,7 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		if !cidrChanged {
 			cidr = ""
 		} else if ipaddrChanged && cidrChanged {
-			return fmt.Errorf("only one of 'ip_addr' and 'cidr' values is allowed to update")
+			return diagFromErr(fmt.Errorf("only one of 'ip_addr' and 'cidr' values is allowed to update"))
 		} else {
 			ipAddr = ""
 		}
@@ -381,21 +386,14 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -409,7 +407,7 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 	// Get by Ref
 	recA, err := objMgr.GetARecordByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read A Record for update operation: %w", err)
+		return diagFromErr(fmt.Errorf("failed to read A Record for update operation: %w", err))
 	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
@@ -422,7 +420,7 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(recA.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	obj, err := objMgr.UpdateARecord(
@@ -436,29 +434,28 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		comment,
 		newExtAttrs)
 	if err != nil {
-		return fmt.Errorf("error updating A-record: %w", err)
+		return diagFromErr(fmt.Errorf("error updating A-record: %w", err))
 	}
 	updateSuccessful = true
 	d.SetId(obj.Ref)
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("ip_addr", obj.Ipv4Addr); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func resourceARecordDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceARecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -473,8 +470,8 @@ func resourceARecordDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("A", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -488,17 +485,16 @@ func resourceARecordDelete(d *schema.ResourceData, m interface{}) error {
 
 	_, err = objMgr.DeleteARecord(recA.Ref)
 	if err != nil {
-		return fmt.Errorf("deletion of A-record failed: %w", err)
+		return diagFromErr(fmt.Errorf("deletion of A-record failed: %w", err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceARecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -536,11 +532,11 @@ func resourceARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 		return nil, err
 	}
 	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
 		if err != nil {
 			return nil, err
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -572,9 +568,8 @@ func resourceARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 
 	// Resource ARecord update Terraform Internal ID and Ref on NIOS side
 	// After the record is imported, call the update function
-	err = resourceARecordUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceARecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
@@ -714,3 +714,88 @@ func TestAcc_resourceARecord_ea_inheritance(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_resourceARecord_extensible_attributes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckARecordDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "infoblox_zone_auth" "zone1" {
+					fqdn = "test.com"
+				}
+				resource "infoblox_a_record" "foo4"{
+					dns_view = "default"
+					fqdn = "samplearec4.test.com"
+					ip_addr = "10.1.0.4"
+					ext_attrs = jsonencode({
+						"Location" = "test location A"
+					})
+					depends_on = [infoblox_zone_auth.zone1]
+				}`,
+				Check: resource.TestCheckResourceAttr("infoblox_a_record.foo4", "extensible_attributes.#", "0"),
+			},
+			// Moving an attribute from 'ext_attrs' to a block does not change the record's EAs.
+			{
+				Config: `
+				resource "infoblox_zone_auth" "zone1" {
+					fqdn = "test.com"
+				}
+				resource "infoblox_a_record" "foo4"{
+					dns_view = "default"
+					fqdn = "samplearec4.test.com"
+					ip_addr = "10.1.0.4"
+					extensible_attributes {
+						name = "Location"
+						value = "test location A"
+					}
+					extensible_attributes {
+						name = "Site"
+						values = ["Blr", "Pune"]
+					}
+					depends_on = [infoblox_zone_auth.zone1]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccARecordCompare(t, "infoblox_a_record.foo4", &ibclient.RecordA{
+						Ipv4Addr: utils.StringPtr("10.1.0.4"),
+						Name:     utils.StringPtr("samplearec4.test.com"),
+						View:     "default",
+						UseTtl:   utils.BoolPtr(false),
+						Ea: ibclient.EA{
+							"Location": "test location A",
+							"Site":     []string{"Blr", "Pune"},
+						},
+					}, "", "", ""),
+					resource.TestCheckResourceAttr("infoblox_a_record.foo4", "ext_attrs", ""),
+					resource.TestCheckTypeSetElemNestedAttrs("infoblox_a_record.foo4", "extensible_attributes.*", map[string]string{
+						"name":  "Location",
+						"value": "test location A",
+					}),
+				),
+			},
+			{
+				Config: `
+				resource "infoblox_zone_auth" "zone1" {
+					fqdn = "test.com"
+				}
+				resource "infoblox_a_record" "foo4"{
+					dns_view = "default"
+					fqdn = "samplearec4.test.com"
+					ip_addr = "10.1.0.4"
+					ext_attrs = jsonencode({
+						"Location" = "test location A"
+					})
+					extensible_attributes {
+						name = "Location"
+						value = "test location B"
+					}
+					depends_on = [infoblox_zone_auth.zone1]
+				}`,
+				ExpectError: regexp.MustCompile("must not be set in both 'ext_attrs' and 'extensible_attributes' fields"),
+			},
+		},
+	})
+}
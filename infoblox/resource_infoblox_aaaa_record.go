@@ -4,19 +4,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func resourceAAAARecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAAAARecordCreate,
-		Read:   resourceAAAARecordGet,
-		Update: resourceAAAARecordUpdate,
-		Delete: resourceAAAARecordDelete,
+		CreateContext: resourceAAAARecordCreate,
+		ReadContext:   resourceAAAARecordGet,
+		UpdateContext: resourceAAAARecordUpdate,
+		DeleteContext: resourceAAAARecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceAAAARecordImport,
+			StateContext: resourceAAAARecordImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -86,6 +88,7 @@ func resourceAAAARecord() *schema.Resource {
 				Default:     "",
 				Description: "Extensible attributes of the AAAA-record to be added/updated, as a map in JSON format",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -102,10 +105,10 @@ func resourceAAAARecord() *schema.Resource {
 	}
 }
 
-func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourceAAAARecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	networkView := d.Get("network_view").(string)
@@ -118,11 +121,11 @@ func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
 	ipv6Addr := d.Get("ipv6_addr").(string)
 	nextAvailableFilter := d.Get("filter_params").(string)
 	if ipv6Addr == "" && cidr == "" && nextAvailableFilter == "" {
-		return fmt.Errorf("any one of 'ipv6_addr', 'cidr' and 'filter_params' values is required")
+		return diagFromErr(fmt.Errorf("any one of 'ipv6_addr', 'cidr' and 'filter_params' values is required"))
 	}
 
 	if ipv6Addr != "" && cidr != "" && nextAvailableFilter != "" {
-		return fmt.Errorf("only one of 'ipv6_addr', 'cidr' and 'filter_params' values is allowed to be defined")
+		return diagFromErr(fmt.Errorf("only one of 'ipv6_addr', 'cidr' and 'filter_params' values is allowed to be defined"))
 	}
 
 	var ttl uint32
@@ -133,17 +136,18 @@ func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	// Generate internal ID and add it to the extensible attributes
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -165,7 +169,7 @@ func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
 		err = json.Unmarshal([]byte(nextAvailableFilter), &eaMap)
 		eaMap["network_view"] = networkView
 		if err != nil {
-			return fmt.Errorf("error unmarshalling extra attributes of network: %s", err)
+			return diagFromErr(fmt.Errorf("error unmarshalling extra attributes of network: %w", err))
 		}
 		newRecordAAAA, err = objMgr.AllocateNextAvailableIp(fqdn, "record:aaaa", eaMap, nil, false, extAttrs, comment, false, nil, "IPV6",
 			false, false, "", "", networkView, dnsViewName, false, ttl, nil)
@@ -173,43 +177,42 @@ func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
 		newRecordAAAA, err = objMgr.CreateAAAARecord(networkView, dnsViewName, fqdn, cidr, ipv6Addr, useTtl, ttl, comment, extAttrs)
 	}
 	if err != nil {
-		return fmt.Errorf("creation of AAAA-record under DNS view '%s' failed: %w", dnsViewName, err)
+		return diagFromErr(fmt.Errorf("creation of AAAA-record under DNS view '%s' failed: %w", dnsViewName, err))
 	}
 
 	recordAAAA := newRecordAAAA.(*ibclient.RecordAAAA)
 	d.SetId(recordAAAA.Ref)
 
 	if err = d.Set("ref", recordAAAA.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("ipv6_addr", recordAAAA.Ipv6Addr); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if val, ok := d.GetOk("network_view"); !ok || val.(string) == "" {
 		dnsViewObj, err := objMgr.GetDNSView(dnsViewName)
 		if err != nil {
-			return fmt.Errorf(
+			return diagFromErr(fmt.Errorf(
 				"error while retrieving information about DNS view '%s': %w",
-				dnsViewName, err)
+				dnsViewName, err))
 		}
 		if err = d.Set("network_view", dnsViewObj.NetworkView); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceAAAARecordGet(d *schema.ResourceData, m interface{}) error {
+func resourceAAAARecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -223,8 +226,8 @@ func resourceAAAARecordGet(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("AAAA", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -237,10 +240,10 @@ func resourceAAAARecordGet(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &obj)
 
 	if err != nil && obj.Ref != "" {
-		return fmt.Errorf("getting AAAA Record with ID: %s failed: %w", d.Id(), err)
+		return diagFromErr(fmt.Errorf("getting AAAA Record with ID: %s failed: %w", d.Id(), err))
 	}
 	if err = d.Set("ipv6_addr", obj.Ipv6Addr); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if obj.Ttl != nil {
@@ -250,47 +253,47 @@ func resourceAAAARecordGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	delete(obj.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("dns_view", obj.View); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if val, ok := d.GetOk("network_view"); !ok || val.(string) == "" {
 		dnsView, err := objMgr.GetDNSView(obj.View)
 		if err != nil {
-			return fmt.Errorf(
+			return diagFromErr(fmt.Errorf(
 				"error while retrieving information about DNS view '%s': %w",
-				obj.View, err)
+				obj.View, err))
 		}
 		if err = d.Set("network_view", dnsView.NetworkView); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("fqdn", obj.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(obj.Ref)
@@ -298,7 +301,7 @@ func resourceAAAARecordGet(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceAAAARecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -314,6 +317,7 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("network_view", prevNetView.(string))
 			_ = d.Set("dns_view", prevDNSView.(string))
@@ -324,24 +328,25 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 
 		}
 	}()
 
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 
 	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'network_view' field is not allowed"))
 	}
 
 	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
 	}
 
 	if d.HasChange("filter_params") {
-		return fmt.Errorf("changing the value of 'filter_params' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'filter_params' field is not allowed"))
 	}
 
 	networkView := d.Get("network_view").(string)
@@ -368,7 +373,7 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		if !cidrChanged {
 			cidr = ""
 		} else if ipaddrChanged && cidrChanged {
-			return fmt.Errorf("only one of 'ipv6_addr' and 'cidr' values is allowed to update")
+			return diagFromErr(fmt.Errorf("only one of 'ipv6_addr' and 'cidr' values is allowed to update"))
 		} else {
 			ipv6Addr = ""
 		}
@@ -382,21 +387,14 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
-	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -409,7 +407,7 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	qarec, err := objMgr.GetAAAARecordByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read AAAA Record for update operation: %w", err)
+		return diagFromErr(fmt.Errorf("failed to read AAAA Record for update operation: %w", err))
 	}
 
 	internalId := d.Get("internal_id").(string)
@@ -423,7 +421,7 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(qarec.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	recordAAAA, err := objMgr.UpdateAAAARecord(
@@ -437,31 +435,30 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		comment,
 		newExtAttrs)
 	if err != nil {
-		return fmt.Errorf("error updating AAAA-record: %w", err)
+		return diagFromErr(fmt.Errorf("error updating AAAA-record: %w", err))
 	}
 	updateSuccessful = true
 	d.SetId(recordAAAA.Ref)
 	if err = d.Set("ref", recordAAAA.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("ipv6_addr", recordAAAA.Ipv6Addr); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func resourceAAAARecordDelete(d *schema.ResourceData, m interface{}) error {
+func resourceAAAARecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	dnsView := d.Get("dns_view").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -475,8 +472,8 @@ func resourceAAAARecordDelete(d *schema.ResourceData, m interface{}) error {
 	qarec, err := searchObjectByRefOrInternalId("AAAA", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -489,22 +486,21 @@ func resourceAAAARecordDelete(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &obj)
 
 	if err != nil {
-		return fmt.Errorf("getting AAAA Record with ID: %s failed: %w", d.Id(), err)
+		return diagFromErr(fmt.Errorf("getting AAAA Record with ID: %s failed: %w", d.Id(), err))
 	}
 
 	_, err = objMgr.DeleteAAAARecord(obj.Ref)
 	if err != nil {
-		return fmt.Errorf("deletion of AAAA Record from dns view %s failed: %w", dnsView, err)
+		return diagFromErr(fmt.Errorf("deletion of AAAA Record from dns view %s failed: %w", dnsView, err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceAAAARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceAAAARecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -543,12 +539,12 @@ func resourceAAAARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.
 	}
 
 	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
 		if err != nil {
 			return nil, err
 		}
 
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -578,9 +574,8 @@ func resourceAAAARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.
 
 	d.SetId(obj.Ref)
 
-	err = resourceAAAARecordUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceAAAARecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 
 	return []*schema.ResourceData{d}, nil
@@ -0,0 +1,438 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var caaRecordReturnFields = []string{
+	"ca_flag", "ca_tag", "ca_value", "comment", "disable", "extattrs", "name", "ttl", "use_ttl", "view", "zone",
+}
+
+func newEmptyCaaRecord() *ibclient.RecordCaa {
+	obj := &ibclient.RecordCaa{}
+	obj.SetReturnFields(caaRecordReturnFields)
+
+	return obj
+}
+
+var (
+	caaIssuerDomainRegexp = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?\.?$`)
+	caaParameterRegexp    = regexp.MustCompile(`^[a-zA-Z0-9]+=[\x21-\x3a\x3c-\x7e]*$`)
+)
+
+// validateCaaValue checks the value of a CAA-record against its tag, as defined by RFC 8659.
+func validateCaaValue(tag, value string) error {
+	switch tag {
+	case "issue", "issuewild":
+		// issuer-domain-name [";" parameter *(";" parameter)], the domain name may be empty
+		parts := strings.Split(value, ";")
+		if domain := strings.TrimSpace(parts[0]); domain != "" && !caaIssuerDomainRegexp.MatchString(domain) {
+			return fmt.Errorf("'%s' is not a valid issuer domain name for the '%s' tag", domain, tag)
+		}
+		for _, param := range parts[1:] {
+			if param = strings.TrimSpace(param); param != "" && !caaParameterRegexp.MatchString(param) {
+				return fmt.Errorf("'%s' is not a valid 'key=value' parameter for the '%s' tag", param, tag)
+			}
+		}
+	case "iodef":
+		u, err := url.Parse(value)
+		if err != nil {
+			return fmt.Errorf("'%s' is not a valid URL for the 'iodef' tag: %w", value, err)
+		}
+		switch u.Scheme {
+		case "mailto":
+			if u.Opaque == "" {
+				return fmt.Errorf("'%s' contains no e-mail address", value)
+			}
+		case "http", "https":
+			if u.Host == "" {
+				return fmt.Errorf("'%s' contains no host", value)
+			}
+		default:
+			return fmt.Errorf("the URL for the 'iodef' tag must have 'mailto', 'http' or 'https' scheme, got '%s'", value)
+		}
+	default:
+		return fmt.Errorf("unsupported CAA tag '%s'", tag)
+	}
+
+	return nil
+}
+
+func resourceCAARecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCAARecordCreate,
+		ReadContext:   resourceCAARecordGet,
+		UpdateContext: resourceCAARecordUpdate,
+		DeleteContext: resourceCAARecordDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCAARecordImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			if d.NewValueKnown("ca_tag") && d.NewValueKnown("ca_value") {
+				if err := validateCaaValue(d.Get("ca_tag").(string), d.Get("ca_value").(string)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view in which the record's zone exists.",
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "FQDN for the CAA-record.",
+			},
+			"ca_flag": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntInSlice([]int{0, 128}),
+				Description:  "Flag of the CAA-record: 0, or 128 for the issuer critical flag.",
+			},
+			"ca_tag": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"issue", "issuewild", "iodef"}, false),
+				Description:  "Property tag of the CAA-record: 'issue', 'issuewild' or 'iodef'.",
+			},
+			"ca_value": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "Value of the CAA-record: the issuer domain name with optional parameters " +
+					"for the 'issue' and 'issuewild' tags, or the URL to report the violations to for the 'iodef' tag.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value of the CAA-record",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the CAA-record.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the CAA-record to be added/updated, as a map in JSON format",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+// caaRecordFromResource makes a CAA-record of the resource's fields, except for the DNS view and the extensible attributes.
+func caaRecordFromResource(d *schema.ResourceData) (*ibclient.RecordCaa, error) {
+	fqdn := d.Get("fqdn").(string)
+	caFlag := uint32(d.Get("ca_flag").(int))
+	caTag := d.Get("ca_tag").(string)
+	caValue := d.Get("ca_value").(string)
+	if err := validateCaaValue(caTag, caValue); err != nil {
+		return nil, err
+	}
+	comment := d.Get("comment").(string)
+
+	ttl, useTtl, err := ttlFromResource(d)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &ibclient.RecordCaa{
+		Name:    &fqdn,
+		CaFlag:  &caFlag,
+		CaTag:   &caTag,
+		CaValue: &caValue,
+		Comment: &comment,
+		Ttl:     &ttl,
+		UseTtl:  &useTtl,
+	}
+
+	return obj, nil
+}
+
+func setCAARecordFields(d *schema.ResourceData, obj *ibclient.RecordCaa) error {
+	ttl := ttlUndef
+	if obj.Ttl != nil && obj.UseTtl != nil && *obj.UseTtl {
+		ttl = int(*obj.Ttl)
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+	var caFlag int
+	if obj.CaFlag != nil {
+		caFlag = int(*obj.CaFlag)
+	}
+	if err := d.Set("ca_flag", caFlag); err != nil {
+		return err
+	}
+	if err := d.Set("ca_tag", stringPtrValue(obj.CaTag)); err != nil {
+		return err
+	}
+	if err := d.Set("ca_value", stringPtrValue(obj.CaValue)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", stringPtrValue(obj.Comment)); err != nil {
+		return err
+	}
+	if err := d.Set("dns_view", stringPtrValue(obj.View)); err != nil {
+		return err
+	}
+
+	return d.Set("fqdn", stringPtrValue(obj.Name))
+}
+
+func resourceCAARecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	obj, err := caaRecordFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	dnsView := d.Get("dns_view").(string)
+	obj.View = &dnsView
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	obj.Ea = extAttrs
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(obj)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("creation of CAA-record failed: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceCAARecordGet(ctx, d, m)
+}
+
+func resourceCAARecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var obj ibclient.RecordCaa
+	if err = getObjectByRefOrInternalId(newEmptyCaaRecord(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setCAARecordFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceCAARecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevFQDN, _ := d.GetChange("fqdn")
+			prevCaFlag, _ := d.GetChange("ca_flag")
+			prevCaTag, _ := d.GetChange("ca_tag")
+			prevCaValue, _ := d.GetChange("ca_value")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("fqdn", prevFQDN.(string))
+			_ = d.Set("ca_flag", prevCaFlag.(int))
+			_ = d.Set("ca_tag", prevCaTag.(string))
+			_ = d.Set("ca_value", prevCaValue.(string))
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+	if d.HasChange("dns_view") {
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
+	}
+
+	obj, err := caaRecordFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var found ibclient.RecordCaa
+	if err = getObjectByRefOrInternalId(newEmptyCaaRecord(), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	obj.Ea, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := connector.UpdateObject(obj, found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error updating CAA-record: %w", err))
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceCAARecordGet(ctx, d, m)
+}
+
+func resourceCAARecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.RecordCaa
+	if err := getObjectByRefOrInternalId(newEmptyCaaRecord(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err := m.(ibclient.IBConnector).DeleteObject(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("deletion of CAA-record failed: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCAARecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	var obj ibclient.RecordCaa
+	err := m.(ibclient.IBConnector).GetObject(newEmptyCaaRecord(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting CAA-record: %w", err)
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setCAARecordFields(d, &obj); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceCAARecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
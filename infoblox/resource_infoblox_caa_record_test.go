@@ -0,0 +1,133 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func TestValidateCaaValue(t *testing.T) {
+	valid := []struct{ tag, value string }{
+		{"issue", "letsencrypt.org"},
+		{"issue", ";"},
+		{"issue", "ca.example.net; account=230123; policy=ev"},
+		{"issuewild", "pki.example.com."},
+		{"iodef", "mailto:security@example.com"},
+		{"iodef", "https://iodef.example.com/report"},
+	}
+	for _, tc := range valid {
+		if err := validateCaaValue(tc.tag, tc.value); err != nil {
+			t.Errorf("%s %q: unexpected error: %s", tc.tag, tc.value, err)
+		}
+	}
+
+	invalid := []struct{ tag, value string }{
+		{"issue", "ca_example.net"},
+		{"issue", "ca.example.net; account"},
+		{"issuewild", "-ca.example.net"},
+		{"iodef", "security@example.com"},
+		{"iodef", "ftp://iodef.example.com"},
+		{"iodef", "https:///report"},
+		{"tbs", "anything"},
+	}
+	for _, tc := range invalid {
+		if err := validateCaaValue(tc.tag, tc.value); err == nil {
+			t.Errorf("%s %q: expected an error", tc.tag, tc.value)
+		}
+	}
+}
+
+func testAccCheckCAARecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_caa_record" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.RecordCaa
+		err := connector.GetObject(newEmptyCaaRecord(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("CAA-record %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceCAARecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCAARecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "caa.test.com"
+					}
+					resource "infoblox_caa_record" "rec1" {
+						fqdn     = "caa.test.com"
+						ca_tag   = "issue"
+						ca_value = "letsencrypt.org"
+						ttl      = 3600
+						comment  = "only Let's Encrypt may issue"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+						depends_on = [infoblox_zone_auth.zone1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_caa_record.rec1", "dns_view", "default"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.rec1", "ca_flag", "0"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.rec1", "ca_tag", "issue"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.rec1", "ca_value", "letsencrypt.org"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.rec1", "ttl", "3600"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.rec1", "ext_attrs", `{"Site":"HQ"}`),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "caa.test.com"
+					}
+					resource "infoblox_caa_record" "rec1" {
+						fqdn       = "caa.test.com"
+						ca_flag    = 128
+						ca_tag     = "iodef"
+						ca_value   = "mailto:security@test.com"
+						depends_on = [infoblox_zone_auth.zone1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_caa_record.rec1", "ca_flag", "128"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.rec1", "ca_tag", "iodef"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.rec1", "ca_value", "mailto:security@test.com"),
+					resource.TestCheckResourceAttr("infoblox_caa_record.rec1", "ttl", fmt.Sprintf("%d", ttlUndef)),
+					resource.TestCheckResourceAttr("infoblox_caa_record.rec1", "comment", ""),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "caa.test.com"
+					}
+					resource "infoblox_caa_record" "rec1" {
+						fqdn       = "caa.test.com"
+						ca_tag     = "iodef"
+						ca_value   = "security@test.com"
+						depends_on = [infoblox_zone_auth.zone1]
+					}`,
+				ExpectError: regexp.MustCompile("'mailto', 'http' or 'https' scheme"),
+			},
+			{
+				ResourceName:            "infoblox_caa_record.rec1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
@@ -4,20 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func resourceCNAMERecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCNAMERecordCreate,
-		Read:   resourceCNAMERecordGet,
-		Update: resourceCNAMERecordUpdate,
-		Delete: resourceCNAMERecordDelete,
+		CreateContext: resourceCNAMERecordCreate,
+		ReadContext:   resourceCNAMERecordGet,
+		UpdateContext: resourceCNAMERecordUpdate,
+		DeleteContext: resourceCNAMERecordDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceCNAMERecordImport,
+			StateContext: resourceCNAMERecordImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -63,6 +65,7 @@ func resourceCNAMERecord() *schema.Resource {
 				Default:     "",
 				Description: "The Extensible attributes of CNAME record, as a map in JSON format",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -79,22 +82,23 @@ func resourceCNAMERecord() *schema.Resource {
 	}
 }
 
-func resourceCNAMERecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourceCNAMERecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	dnsView := d.Get("dns_view").(string)
 	canonical := d.Get("canonical").(string)
 	alias := d.Get("alias").(string)
 
 	comment := d.Get("comment").(string)
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	var ttl uint32
 	useTtl := false
 	tempVal := d.Get("ttl")
@@ -103,7 +107,7 @@ func resourceCNAMERecordCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	var tenantID string
@@ -119,33 +123,32 @@ func resourceCNAMERecordCreate(d *schema.ResourceData, m interface{}) error {
 
 	recordCNAME, err := objMgr.CreateCNAMERecord(dnsView, canonical, alias, useTtl, ttl, comment, extAttrs)
 	if err != nil {
-		return fmt.Errorf("creation of CNAME Record under %s DNS View failed: %s", dnsView, err.Error())
+		return diagFromErr(fmt.Errorf("creation of CNAME Record under %s DNS View failed: %w", dnsView, err))
 	}
 
 	d.SetId(recordCNAME.Ref)
 
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", recordCNAME.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	return nil
 }
 
-func resourceCNAMERecordGet(d *schema.ResourceData, m interface{}) error {
+func resourceCNAMERecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rec, err := searchObjectByRefOrInternalId("CNAME", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -157,17 +160,17 @@ func resourceCNAMERecordGet(d *schema.ResourceData, m interface{}) error {
 	recJson, _ := json.Marshal(rec)
 	err = json.Unmarshal(recJson, &obj)
 	if err != nil {
-		return fmt.Errorf("getting CNAME Record with ID: %s failed: %s", d.Id(), err.Error())
+		return diagFromErr(fmt.Errorf("getting CNAME Record with ID: %s failed: %w", d.Id(), err))
 	}
 
 	if err = d.Set("alias", obj.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("canonical", obj.Canonical); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("comment", obj.Comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if obj.Ttl != nil {
@@ -178,26 +181,26 @@ func resourceCNAMERecordGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	delete(obj.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("dns_view", obj.View); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(obj.Ref)
@@ -205,7 +208,7 @@ func resourceCNAMERecordGet(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceCNAMERecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceCNAMERecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -218,6 +221,7 @@ func resourceCNAMERecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("dns_view", prevDNSView.(string))
 			_ = d.Set("canonical", prevCanonical.(string))
@@ -225,14 +229,15 @@ func resourceCNAMERecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 		}
 	}()
 
 	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
 	}
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 
 	dnsView := d.Get("dns_view").(string)
@@ -240,16 +245,9 @@ func resourceCNAMERecordUpdate(d *schema.ResourceData, m interface{}) error {
 	alias := d.Get("alias").(string)
 	comment := d.Get("comment").(string)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var ttl uint32
@@ -260,7 +258,7 @@ func resourceCNAMERecordUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	var tenantID string
@@ -272,7 +270,7 @@ func resourceCNAMERecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	crec, err := objMgr.GetCNAMERecordByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read CNAME record for update operation: %w", err)
+		return diagFromErr(fmt.Errorf("failed to read CNAME record for update operation: %w", err))
 	}
 
 	// Generate internal ID and add it to the extensible attributes if not set
@@ -285,32 +283,31 @@ func resourceCNAMERecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(crec.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	recordCNAME, err := objMgr.UpdateCNAMERecord(d.Id(), canonical, alias, useTtl, ttl, comment, newExtAttrs)
 	if err != nil {
-		return fmt.Errorf("updation of CNAME Record under %s DNS View failed: %s", dnsView, err.Error())
+		return diagFromErr(fmt.Errorf("updation of CNAME Record under %s DNS View failed: %w", dnsView, err))
 	}
 	updateSuccessful = true
 
 	if err = d.Set("ref", recordCNAME.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(recordCNAME.Ref)
 
 	return nil
 }
 
-func resourceCNAMERecordDelete(d *schema.ResourceData, m interface{}) error {
+func resourceCNAMERecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	dnsView := d.Get("dns_view").(string)
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -323,8 +320,8 @@ func resourceCNAMERecordDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("CNAME", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -338,17 +335,16 @@ func resourceCNAMERecordDelete(d *schema.ResourceData, m interface{}) error {
 
 	_, err = objMgr.DeleteCNAMERecord(crec.Ref)
 	if err != nil {
-		return fmt.Errorf("deletion of CNAME Record from dns view %s failed: %s", dnsView, err.Error())
+		return diagFromErr(fmt.Errorf("deletion of CNAME Record from dns view %s failed: %w", dnsView, err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceCNAMERecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceCNAMERecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -362,7 +358,7 @@ func resourceCNAMERecordImport(d *schema.ResourceData, m interface{}) ([]*schema
 
 	obj, err := objMgr.GetCNAMERecordByRef(d.Id())
 	if err != nil {
-		return nil, fmt.Errorf("getting CNAME Record with ID: %s failed: %s", d.Id(), err.Error())
+		return nil, fmt.Errorf("getting CNAME Record with ID: %s failed: %w", d.Id(), err)
 	}
 
 	if err = d.Set("alias", obj.Name); err != nil {
@@ -387,11 +383,11 @@ func resourceCNAMERecordImport(d *schema.ResourceData, m interface{}) ([]*schema
 	}
 
 	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
 		if err != nil {
 			return nil, err
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -407,9 +403,8 @@ func resourceCNAMERecordImport(d *schema.ResourceData, m interface{}) ([]*schema
 	}
 
 	// Update the resource with the EA Terraform Internal ID
-	err = resourceCNAMERecordUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceCNAMERecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 
 	return []*schema.ResourceData{d}, nil
@@ -0,0 +1,350 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var dnameRecordReturnFields = []string{
+	"comment", "extattrs", "name", "target", "ttl", "use_ttl", "view", "zone",
+}
+
+func newEmptyDnameRecord() *ibclient.RecordDname {
+	obj := &ibclient.RecordDname{}
+	obj.SetReturnFields(dnameRecordReturnFields)
+
+	return obj
+}
+
+func resourceDNAMERecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNAMERecordCreate,
+		ReadContext:   resourceDNAMERecordGet,
+		UpdateContext: resourceDNAMERecordUpdate,
+		DeleteContext: resourceDNAMERecordDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDNAMERecordImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The owner name of the DNAME-record, in FQDN format, whose subtree is redirected.",
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The target domain name, in FQDN format, the subtree of 'name' is redirected to.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for the DNAME-record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the DNAME-record.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the DNAME-record to be added/updated, as a map in JSON format.",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+// dnameRecordFromResource makes a DNAME-record of the resource's fields, except for the DNS view and the extensible attributes.
+func dnameRecordFromResource(d *schema.ResourceData) (*ibclient.RecordDname, error) {
+	name := d.Get("name").(string)
+	target := d.Get("target").(string)
+	comment := d.Get("comment").(string)
+
+	ttl, useTtl, err := ttlFromResource(d)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &ibclient.RecordDname{
+		Name:    &name,
+		Target:  &target,
+		Comment: &comment,
+		Ttl:     &ttl,
+		UseTtl:  &useTtl,
+	}
+
+	return obj, nil
+}
+
+func setDNAMERecordFields(d *schema.ResourceData, obj *ibclient.RecordDname) error {
+	ttl := ttlUndef
+	if obj.Ttl != nil && obj.UseTtl != nil && *obj.UseTtl {
+		ttl = int(*obj.Ttl)
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+	if err := d.Set("target", stringPtrValue(obj.Target)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", stringPtrValue(obj.Comment)); err != nil {
+		return err
+	}
+	if err := d.Set("dns_view", obj.View); err != nil {
+		return err
+	}
+
+	return d.Set("name", stringPtrValue(obj.Name))
+}
+
+func resourceDNAMERecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	obj, err := dnameRecordFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	obj.View = d.Get("dns_view").(string)
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	obj.Ea = extAttrs
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(obj)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("creation of DNAME-record failed: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceDNAMERecordGet(ctx, d, m)
+}
+
+func resourceDNAMERecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var obj ibclient.RecordDname
+	if err = getObjectByRefOrInternalId(newEmptyDnameRecord(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setDNAMERecordFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceDNAMERecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevName, _ := d.GetChange("name")
+			prevTarget, _ := d.GetChange("target")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("target", prevTarget.(string))
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+	if d.HasChange("dns_view") {
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
+	}
+
+	obj, err := dnameRecordFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var found ibclient.RecordDname
+	if err = getObjectByRefOrInternalId(newEmptyDnameRecord(), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	obj.Ea, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := connector.UpdateObject(obj, found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error updating DNAME-record: %w", err))
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceDNAMERecordGet(ctx, d, m)
+}
+
+func resourceDNAMERecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.RecordDname
+	if err := getObjectByRefOrInternalId(newEmptyDnameRecord(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err := m.(ibclient.IBConnector).DeleteObject(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("deletion of DNAME-record failed: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceDNAMERecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	var obj ibclient.RecordDname
+	err := m.(ibclient.IBConnector).GetObject(newEmptyDnameRecord(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting DNAME-record: %w", err)
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setDNAMERecordFields(d, &obj); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceDNAMERecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
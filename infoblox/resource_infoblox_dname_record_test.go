@@ -0,0 +1,80 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckDNAMERecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_dname_record" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.RecordDname
+		err := connector.GetObject(newEmptyDnameRecord(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("DNAME-record %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceDNAMERecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNAMERecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "dname.test.com"
+					}
+					resource "infoblox_dname_record" "rec1" {
+						name    = "old.dname.test.com"
+						target  = "new.example.com"
+						ttl     = 300
+						comment = "after the rebrand"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+						depends_on = [infoblox_zone_auth.zone1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_dname_record.rec1", "name", "old.dname.test.com"),
+					resource.TestCheckResourceAttr("infoblox_dname_record.rec1", "target", "new.example.com"),
+					resource.TestCheckResourceAttr("infoblox_dname_record.rec1", "ttl", "300"),
+					resource.TestCheckResourceAttr("infoblox_dname_record.rec1", "comment", "after the rebrand"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "dname.test.com"
+					}
+					resource "infoblox_dname_record" "rec1" {
+						name       = "old.dname.test.com"
+						target     = "brand.example.org"
+						depends_on = [infoblox_zone_auth.zone1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_dname_record.rec1", "target", "brand.example.org"),
+					resource.TestCheckResourceAttr("infoblox_dname_record.rec1", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "infoblox_dname_record.rec1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
@@ -22,8 +22,9 @@ func resourceDNSView() *schema.Resource {
 		UpdateContext: resourceDNSViewUpdate,
 		DeleteContext: resourceDNSViewDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceDNSViewImport,
+			StateContext: resourceDNSViewImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -60,6 +61,7 @@ func resourceDNSView() *schema.Resource {
 				Optional:    true,
 				Description: "The Extensible attributes of the DNS view to be added/updated, as a map in JSON format",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 
 			"internal_id": {
 				Type:     schema.TypeString,
@@ -80,21 +82,22 @@ func resourceDNSView() *schema.Resource {
 
 func resourceDNSViewCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	if ref := d.Get("ref"); ref.(string) != "" {
-		return diag.FromErr(fmt.Errorf("the value of 'ref' field must not be set manually"))
+		return diagFromErr(fmt.Errorf("the value of 'ref' field must not be set manually"))
 	}
 
 	conn := m.(ibclient.IBConnector)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	// Generate internal ID and add it to the extensible attributes
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -114,16 +117,16 @@ func resourceDNSViewCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	viewRef, err := conn.CreateObject(v)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(viewRef)
 
 	if err = d.Set("ref", viewRef); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceDNSViewRead(ctx, d, m)
@@ -135,13 +138,13 @@ func resourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interfac
 	v.SetReturnFields([]string{"name", "comment", "network_view", "extattrs"})
 
 	if !dnsViewRegExp.MatchString(d.Id()) {
-		return diag.FromErr(fmt.Errorf("reference '%s' for 'view' object has an invalid format", d.Id()))
+		return diagFromErr(fmt.Errorf("reference '%s' for 'view' object has an invalid format", d.Id()))
 	}
 
 	rec, err := searchObjectByRefOrInternalId("DNSView", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
 				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
@@ -155,46 +158,45 @@ func resourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interfac
 	err = json.Unmarshal(recJson, &vResult)
 
 	if err != nil && vResult.Ref != "" {
-		return diag.FromErr(fmt.Errorf("getting DNS View with ID: %s failed: %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("getting DNS View with ID: %s failed: %w", d.Id(), err))
 	}
 
 	if err = d.Set("name", vResult.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if vResult.Comment != nil {
 		if err = d.Set("comment", vResult.Comment); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if vResult.NetworkView != nil {
 		if err = d.Set("network_view", vResult.NetworkView); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
-	extAttrsJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrsJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	delete(vResult.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(vResult.Ea, extAttrs)
+	omittedEAs := omitEAs(vResult.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return diag.FromErr(err)
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 	if err = d.Set("ref", vResult.Ref); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.SetId(vResult.Ref)
 
@@ -203,25 +205,18 @@ func resourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 func resourceDNSViewUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if d.HasChange("internal_id") {
-		return diag.FromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 
 	if d.HasChange("ref") {
-		return diag.FromErr(fmt.Errorf("changing the value of 'ref' field is not allowed"))
+		return diagFromErr(fmt.Errorf("changing the value of 'ref' field is not allowed"))
 	}
 
 	conn := m.(ibclient.IBConnector)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	v := &ibclient.View{}
@@ -231,7 +226,7 @@ func resourceDNSViewUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	err = conn.GetObject(v, d.Id(), nil, &vResult)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read DNS View for update operation: %w", err))
+		return diagFromErr(fmt.Errorf("failed to read DNS View for update operation: %w", err))
 	}
 
 	internalId := d.Get("internal_id").(string)
@@ -245,7 +240,7 @@ func resourceDNSViewUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	mergedExtAttrs, err := mergeEAs(vResult.Ea, newExtAttrs, oldExtAttrs, conn)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	vUpd := &ibclient.View{
 		Name: utils.StringPtr(d.Get("name").(string)),
@@ -263,16 +258,16 @@ func resourceDNSViewUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	viewRef, err := conn.UpdateObject(vUpd, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(viewRef)
 
 	if err = d.Set("ref", viewRef); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceDNSViewRead(ctx, d, m)
@@ -284,7 +279,7 @@ func resourceDNSViewDelete(ctx context.Context, d *schema.ResourceData, m interf
 	rec, err := searchObjectByRefOrInternalId("DNSView", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
 				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
@@ -298,17 +293,17 @@ func resourceDNSViewDelete(ctx context.Context, d *schema.ResourceData, m interf
 	err = json.Unmarshal(recJson, &vResult)
 
 	if err != nil && vResult.Ref != "" {
-		return diag.FromErr(fmt.Errorf("getting DNS View with ID: %s failed: %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("getting DNS View with ID: %s failed: %w", d.Id(), err))
 	}
 
 	if _, err := conn.DeleteObject(vResult.Ref); err != nil {
-		return diag.FromErr(fmt.Errorf("deletion of DNS View failed: %w", err))
+		return diagFromErr(fmt.Errorf("deletion of DNS View failed: %w", err))
 	}
 
 	return nil
 }
 
-func resourceDNSViewImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceDNSViewImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	conn := m.(ibclient.IBConnector)
 
 	viewRef := d.Id()
@@ -343,30 +338,26 @@ func resourceDNSViewImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 		}
 	}
 
-	extAttrsJSON := d.Get("ext_attrs").(string)
-	_, err = terraformDeserializeEAs(extAttrsJSON)
+	_, err = terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
 
 	if vResult.Ea != nil && len(vResult.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(vResult.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(vResult.Ea, m))
 		if err != nil {
 			return nil, err
 		}
 
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
 
 	d.SetId(vResult.Ref)
 
-	var dErr diag.Diagnostics
-	var ctx context.Context
-	dErr = resourceDNSViewUpdate(ctx, d, m)
-	if dErr != nil {
-		return nil, fmt.Errorf("failed to import DNS View: %v", dErr)
+	if diags := resourceDNSViewUpdate(ctx, d, m); diags.HasError() {
+		return nil, fmt.Errorf("failed to import DNS View: %w", diagsToError(diags))
 	}
 
 	return []*schema.ResourceData{d}, nil
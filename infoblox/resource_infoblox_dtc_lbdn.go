@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
@@ -11,13 +12,14 @@ import (
 
 func resourceDtcLbdnRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceDtcLbdnCreate,
-		Read:   resourceDtcLbdnGet,
-		Update: resourceDtcLbdnUpdate,
-		Delete: resourceDtcLbdnDelete,
+		CreateContext: resourceDtcLbdnCreate,
+		ReadContext:   resourceDtcLbdnGet,
+		UpdateContext: resourceDtcLbdnUpdate,
+		DeleteContext: resourceDtcLbdnDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceDtcLbdnImport,
+			StateContext: resourceDtcLbdnImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -78,6 +80,7 @@ func resourceDtcLbdnRecord() *schema.Resource {
 				Default:     "",
 				Description: "Extensible attributes of the DTC LBDN record to be added/updated, as a map in JSON format.",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 			"disable": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -200,17 +203,17 @@ func validateAuthZonesLink(authZones []interface{}) ([]ibclient.AuthZonesLink, e
 	return authZoneList, nil
 }
 
-func resourceDtcLbdnCreate(d *schema.ResourceData, m interface{}) error {
+func resourceDtcLbdnCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	name := d.Get("name").(string)
 	authZones := d.Get("auth_zones").([]interface{})
 	authZonesLink, err := validateAuthZonesLink(authZones)
 	if err != nil {
-		return fmt.Errorf("failed to validate auth_zones: %w", err)
+		return diagFromErr(fmt.Errorf("failed to validate auth_zones: %w", err))
 	}
 
 	autoConsolidatedMonitors := d.Get("auto_consolidated_monitors").(bool)
@@ -221,7 +224,7 @@ func resourceDtcLbdnCreate(d *schema.ResourceData, m interface{}) error {
 
 	pools, err := validatePoolsLink(poolsLink)
 	if err != nil {
-		return fmt.Errorf("failed to validate pools: %w", err)
+		return diagFromErr(fmt.Errorf("failed to validate pools: %w", err))
 	}
 
 	patterns := d.Get("patterns").([]interface{})
@@ -240,7 +243,7 @@ func resourceDtcLbdnCreate(d *schema.ResourceData, m interface{}) error {
 	types := d.Get("types").([]interface{})
 	typesList := make([]string, len(types))
 	if len(types) == 0 {
-		return fmt.Errorf("at least one record type should be selected")
+		return diagFromErr(fmt.Errorf("at least one record type should be selected"))
 	}
 	for i, j := range types {
 		typesList[i] = j.(string)
@@ -254,15 +257,16 @@ func resourceDtcLbdnCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return fmt.Errorf("failed to allocate IP: %w", err)
+		return diagFromErr(fmt.Errorf("failed to allocate IP: %w", err))
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	// Generate internal ID and add it to the extensible attributes
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -278,26 +282,25 @@ func resourceDtcLbdnCreate(d *schema.ResourceData, m interface{}) error {
 	// Create the DTC LBDN record
 	newRecord, err := objMgr.CreateDtcLbdn(name, authZonesLink, comment, disable, autoConsolidatedMonitors, extAttrs, lbMethod, patternsList, persistence, pools, priority, &topology, typesList, ttl, useTtl)
 	if err != nil {
-		return fmt.Errorf("failed to create DTC LBDN record: %w", err)
+		return diagFromErr(fmt.Errorf("failed to create DTC LBDN record: %w", err))
 	}
 	d.SetId(newRecord.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", newRecord.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceDtcLbdnGet(d, m)
+	return resourceDtcLbdnGet(ctx, d, m)
 }
 
-func resourceDtcLbdnGet(d *schema.ResourceData, m interface{}) error {
+func resourceDtcLbdnGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rec, err := searchObjectByRefOrInternalId("DtcLbdn", d, m)
@@ -306,8 +309,8 @@ func resourceDtcLbdnGet(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		} else {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		}
 	}
 
@@ -317,91 +320,91 @@ func resourceDtcLbdnGet(d *schema.ResourceData, m interface{}) error {
 
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal DTC LBDN record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal DTC LBDN record : %w", err))
 	}
 	err = json.Unmarshal(recJson, &dtcLbdn)
 	if err != nil {
-		return fmt.Errorf("failed getting DTC LBDN record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting DTC LBDN record : %w", err))
 	}
 
 	delete(dtcLbdn.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(dtcLbdn.Ea, extAttrs)
+	omittedEAs := omitEAs(dtcLbdn.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if dtcLbdn.Name != nil {
 		if err = d.Set("name", *dtcLbdn.Name); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if dtcLbdn.AuthZones != nil {
 		authZoneInterface, err := ConvertAuthZonesToInterface(connector, dtcLbdn)
 		if err != nil {
-			return fmt.Errorf("failed to convert auth zones to interface: %w", err)
+			return diagFromErr(fmt.Errorf("failed to convert auth zones to interface: %w", err))
 		}
 		if err = d.Set("auth_zones", authZoneInterface); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if dtcLbdn.AutoConsolidatedMonitors != nil {
 		if err = d.Set("auto_consolidated_monitors", *dtcLbdn.AutoConsolidatedMonitors); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if dtcLbdn.Comment != nil {
 		if err = d.Set("comment", *dtcLbdn.Comment); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if dtcLbdn.Disable != nil {
 		if err = d.Set("disable", *dtcLbdn.Disable); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if dtcLbdn.LbMethod != "" {
 		if err = d.Set("lb_method", dtcLbdn.LbMethod); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if dtcLbdn.Patterns != nil {
 		listInterface = convertSliceToInterface(dtcLbdn.Patterns)
 		if err = d.Set("patterns", listInterface); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	listInterface = convertSliceToInterface(dtcLbdn.Types)
 	if err = d.Set("types", listInterface); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if dtcLbdn.Persistence != nil {
 		if err = d.Set("persistence", *dtcLbdn.Persistence); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if dtcLbdn.Priority != nil {
 		if err = d.Set("priority", *dtcLbdn.Priority); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if dtcLbdn.Pools != nil {
 		poolsInterface, err := convertPoolsToInterface(dtcLbdn, connector)
 		if err != nil {
-			return fmt.Errorf("failed to convert pools to interface: %w", err)
+			return diagFromErr(fmt.Errorf("failed to convert pools to interface: %w", err))
 		}
 		if err = d.Set("pools", poolsInterface); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -409,10 +412,10 @@ func resourceDtcLbdnGet(d *schema.ResourceData, m interface{}) error {
 		var res ibclient.DtcTopology
 		err := connector.GetObject(&ibclient.DtcTopology{}, *dtcLbdn.Topology, nil, &res)
 		if err != nil {
-			return fmt.Errorf("failed to get %s topology: %w", *dtcLbdn.Topology, err)
+			return diagFromErr(fmt.Errorf("failed to get %s topology: %w", *dtcLbdn.Topology, err))
 		}
 		if err = d.Set("topology", *res.Name); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if dtcLbdn.Ttl != nil {
@@ -422,11 +425,11 @@ func resourceDtcLbdnGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("ref", dtcLbdn.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(dtcLbdn.Ref)
@@ -485,7 +488,7 @@ func ConvertAuthZonesToInterface(connector ibclient.IBConnector, dtcLbdn *ibclie
 	return authZoneList, nil
 }
 
-func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceDtcLbdnUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		if !updateSuccessful {
@@ -503,6 +506,7 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTypes, _ := d.GetChange("types")
 			prevTtl, _ := d.GetChange("ttl")
 			prevExtAttrs, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("name", prevName.(string))
 			_ = d.Set("auth_zones", prevAuthZones)
@@ -518,19 +522,13 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("types", prevTypes)
 			_ = d.Set("ttl", prevTtl.(int))
 			_ = d.Set("ext_attrs", prevExtAttrs.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 		}
 	}()
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -542,7 +540,7 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 	authZones := d.Get("auth_zones").([]interface{})
 	authZonesLink, err := validateAuthZonesLink(authZones)
 	if err != nil {
-		return fmt.Errorf("failed to validate auth_zones: %w", err)
+		return diagFromErr(fmt.Errorf("failed to validate auth_zones: %w", err))
 	}
 
 	autoConsolidatedMonitors := d.Get("auto_consolidated_monitors").(bool)
@@ -553,7 +551,7 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 
 	pools, err := validatePoolsLink(poolsLink)
 	if err != nil {
-		return fmt.Errorf("failed to validate pools: %w", err)
+		return diagFromErr(fmt.Errorf("failed to validate pools: %w", err))
 	}
 
 	patterns := d.Get("patterns").([]interface{})
@@ -572,7 +570,7 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 	types := d.Get("types").([]interface{})
 	typesList := make([]string, len(types))
 	if len(types) == 0 {
-		return fmt.Errorf("at least one record type should be selected")
+		return diagFromErr(fmt.Errorf("at least one record type should be selected"))
 	}
 	for i, j := range types {
 		typesList[i] = j.(string)
@@ -586,7 +584,7 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	connector := m.(ibclient.IBConnector)
@@ -597,8 +595,8 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("DtcLbdn", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -606,11 +604,11 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal DTC LBDN record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal DTC LBDN record : %w", err))
 	}
 	err = json.Unmarshal(recJson, &lbdn)
 	if err != nil {
-		return fmt.Errorf("failed getting DTC LBDN record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting DTC LBDN record : %w", err))
 	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
@@ -623,31 +621,30 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(lbdn.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbdn, err = objMgr.UpdateDtcLbdn(d.Id(), name, authZonesLink, comment, disable, autoConsolidatedMonitors, newExtAttrs, lbMethod, patternsList, persistence, pools, priority, &topology, typesList, ttl, useTtl)
 	if err != nil {
-		return fmt.Errorf("failed to update DTC LBDN: %s.", err.Error())
+		return diagFromErr(fmt.Errorf("failed to update DTC LBDN: %w.", err))
 	}
 
 	updateSuccessful = true
 
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", lbdn.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(lbdn.Ref)
-	return resourceDtcLbdnGet(d, m)
+	return resourceDtcLbdnGet(ctx, d, m)
 }
 
-func resourceDtcLbdnDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceDtcLbdnDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -662,8 +659,8 @@ func resourceDtcLbdnDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("DtcLbdn", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -673,23 +670,22 @@ func resourceDtcLbdnDelete(d *schema.ResourceData, m interface{}) error {
 	var lbdn *ibclient.DtcLbdn
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal DTC LBDN record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal DTC LBDN record : %w", err))
 	}
 	err = json.Unmarshal(recJson, &lbdn)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	_, err = objMgr.DeleteDtcLbdn(lbdn.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to delete DTC LBDN : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to delete DTC LBDN : %w", err))
 	}
 
 	return nil
 }
 
-func resourceDtcLbdnImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	_, err := terraformDeserializeEAs(extAttrJSON)
+func resourceDtcLbdnImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	_, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -703,11 +699,11 @@ func resourceDtcLbdnImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 	}
 
 	if lbdn.Ea != nil && len(lbdn.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(lbdn.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(lbdn.Ea, m))
 		if err != nil {
 			return nil, err
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -807,9 +803,8 @@ func resourceDtcLbdnImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 	d.SetId(lbdn.Ref)
 
 	// Update the resource with the EA Terraform Internal ID
-	err = resourceDtcLbdnUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceDtcLbdnUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
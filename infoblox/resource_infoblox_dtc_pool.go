@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"reflect"
@@ -150,13 +151,14 @@ func ConvertInterfaceToMonitors(monitorsInterface []interface{}) []ibclient.Moni
 
 func resourceDtcPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceDtcPoolCreate,
-		Read:   resourceDtcPoolGet,
-		Update: resourceDtcPoolUpdate,
-		Delete: resourceDtcPoolDelete,
+		CreateContext: resourceDtcPoolCreate,
+		ReadContext:   resourceDtcPoolGet,
+		UpdateContext: resourceDtcPoolUpdate,
+		DeleteContext: resourceDtcPoolDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceDtcPoolImport,
+			StateContext: resourceDtcPoolImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -277,6 +279,7 @@ func resourceDtcPool() *schema.Resource {
 				Default:     "",
 				Description: "Extensible attributes of the  Dtc Pool to be added/updated, as a map in JSON format",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 			"lb_preferred_method": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -412,18 +415,19 @@ func resourceDtcPool() *schema.Resource {
 	}
 }
 
-func resourceDtcPoolCreate(d *schema.ResourceData, m interface{}) error {
+func resourceDtcPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	name := d.Get("name").(string)
 	comment := d.Get("comment").(string)
 	lbPreferredMethod := d.Get("lb_preferred_method").(string)
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
+
+	extAttrs = withProviderEAs(extAttrs, m)
 	// Generate internal ID and add it to the extensible attributes
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -441,7 +445,7 @@ func resourceDtcPoolCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	serversInterface := d.Get("servers").([]interface{})
@@ -453,7 +457,7 @@ func resourceDtcPoolCreate(d *schema.ResourceData, m interface{}) error {
 	lbDynamicRatioJson := d.Get("lb_dynamic_ratio_preferred").(string)
 	lbDynamicRatioPreferred, err := ConvertDynamicRatioPreferredToInterface(lbDynamicRatioJson, lbPreferredMethod, "")
 	if err != nil {
-		return fmt.Errorf("lb_dynamic_ratio_preferred : %s", err.Error())
+		return diagFromErr(fmt.Errorf("lb_dynamic_ratio_preferred : %w", err))
 	}
 	lbPreferredTopologyValue := d.Get("lb_preferred_topology").(string)
 	var lbPreferredTopology *string
@@ -472,11 +476,11 @@ func resourceDtcPoolCreate(d *schema.ResourceData, m interface{}) error {
 	lbDynamicRatioAlternateJson := d.Get("lb_dynamic_ratio_alternate").(string)
 	lbDynamicRatioAlternate, err := ConvertDynamicRatioPreferredToInterface(lbDynamicRatioAlternateJson, lbPreferredMethod, lbAlternateMethod)
 	if err != nil {
-		return fmt.Errorf("lb_dynamic_ratio_alternate : %s", err.Error())
+		return diagFromErr(fmt.Errorf("lb_dynamic_ratio_alternate : %w", err))
 	}
 	consolidatedMonitorsInterface, ok1 := d.GetOk("consolidated_monitors")
 	if autoConsolidatedMonitors && ok1 {
-		return fmt.Errorf("either consolidated_monitors or auto_consolidated_monitors should be set")
+		return diagFromErr(fmt.Errorf("either consolidated_monitors or auto_consolidated_monitors should be set"))
 	}
 	consolidatedMonitorsList := consolidatedMonitorsInterface.([]interface{})
 	consolidatedMonitors := convertInterfaceToList(consolidatedMonitorsList)
@@ -487,24 +491,23 @@ func resourceDtcPoolCreate(d *schema.ResourceData, m interface{}) error {
 
 	newDtcPool, err := objMgr.CreateDtcPool(comment, name, lbPreferredMethod, lbDynamicRatioPreferred, servers, monitors, lbPreferredTopology, lbAlternateMethod, lbAlternateTopology, lbDynamicRatioAlternate, extAttrs, autoConsolidatedMonitors, consolidatedMonitors, availability, ttl, useTtl, disable, quorum)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(newDtcPool.Ref)
 	if err = d.Set("ref", newDtcPool.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	return resourceDtcPoolGet(d, m)
+	return resourceDtcPoolGet(ctx, d, m)
 }
 
-func resourceDtcPoolGet(d *schema.ResourceData, m interface{}) error {
+func resourceDtcPoolGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	connector := m.(ibclient.IBConnector)
@@ -514,29 +517,29 @@ func resourceDtcPoolGet(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		} else {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		}
 	}
 	var dtcPool *ibclient.DtcPool
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal DTC Pool : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal DTC Pool : %w", err))
 	}
 	err = json.Unmarshal(recJson, &dtcPool)
 	if err != nil {
-		return fmt.Errorf("failed getting DTC pool : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting DTC pool : %w", err))
 	}
 	delete(dtcPool.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(dtcPool.Ea, extAttrs)
+	omittedEAs := omitEAs(dtcPool.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 	if dtcPool.Ttl != nil {
@@ -546,88 +549,88 @@ func resourceDtcPoolGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("availability", dtcPool.Availability); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if dtcPool.Quorum != nil {
 		if err = d.Set("quorum", *dtcPool.Quorum); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("name", dtcPool.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("comment", dtcPool.Comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("disable", dtcPool.Disable); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("lb_preferred_method", dtcPool.LbPreferredMethod); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("auto_consolidated_monitors", dtcPool.AutoConsolidatedMonitors); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if dtcPool.AutoConsolidatedMonitors != nil {
 		if !(*dtcPool.AutoConsolidatedMonitors) {
 			consolidatedMonitorsInterface, err := convertConsolidatedMonitorsToInterface(dtcPool.ConsolidatedMonitors, connector)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			if err = d.Set("consolidated_monitors", consolidatedMonitorsInterface); err != nil {
-				return err
+				return diagFromErr(err)
 			}
 		}
 	}
 	slInterface, err := convertDtcServerLinksToInterface(dtcPool.Servers, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("servers", slInterface); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	monitorsInterface := convertMonitorsToInterface(dtcPool.Monitors, connector)
 	if err = d.Set("monitors", monitorsInterface); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if dtcPool.LbPreferredTopology != nil {
 		var topologies ibclient.DtcTopology
 		err = connector.GetObject(&ibclient.DtcTopology{}, *dtcPool.LbPreferredTopology, nil, &topologies)
 		topologyPreferredName := topologies.Name
 		if err = d.Set("lb_preferred_topology", topologyPreferredName); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err = d.Set("lb_preferred_topology", nil); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if dtcPool.LbDynamicRatioPreferred != nil && dtcPool.LbPreferredMethod == "DYNAMIC_RATIO" {
 		dynamicRatioInterface, _ := serializeSettingDynamicRatio(dtcPool.LbDynamicRatioPreferred, connector)
 		if err := d.Set("lb_dynamic_ratio_preferred", dynamicRatioInterface); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err := d.Set("lb_dynamic_ratio_preferred", nil); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("lb_alternate_method", dtcPool.LbAlternateMethod); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if dtcPool.LbDynamicRatioAlternate != nil && dtcPool.LbAlternateMethod == "DYNAMIC_RATIO" {
 		dynamicRatioInterface, _ := serializeSettingDynamicRatio(dtcPool.LbDynamicRatioAlternate, connector)
 		if err := d.Set("lb_dynamic_ratio_alternate", dynamicRatioInterface); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err := d.Set("lb_dynamic_ratio_alternate", nil); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if dtcPool.LbAlternateTopology != nil {
@@ -635,22 +638,22 @@ func resourceDtcPoolGet(d *schema.ResourceData, m interface{}) error {
 		err = connector.GetObject(&ibclient.DtcTopology{}, *dtcPool.LbAlternateTopology, nil, &topologiesAlternate)
 		topologyAlternateName := topologiesAlternate.Name
 		if err = d.Set("lb_alternate_topology", topologyAlternateName); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err = d.Set("lb_alternate_topology", nil); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("ref", dtcPool.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(dtcPool.Ref)
 	return nil
 }
 
-func resourceDtcPoolUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceDtcPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	var updateSuccessful bool
 	defer func() {
@@ -664,6 +667,7 @@ func resourceDtcPoolUpdate(d *schema.ResourceData, m interface{}) error {
 			prevConsolidatedMonitors, _ := d.GetChange("consolidated_monitors")
 			prevDisable, _ := d.GetChange("disable")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 			prevLbPreferredMethod, _ := d.GetChange("lb_preferred_method")
 			prevLbDynamicRatioPreferred, _ := d.GetChange("lb_dynamic_ratio_preferred")
 			prevLbPreferredTopology, _ := d.GetChange("lb_preferred_topology")
@@ -682,6 +686,7 @@ func resourceDtcPoolUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("consolidated_monitors", prevConsolidatedMonitors)
 			_ = d.Set("disable", prevDisable.(bool))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 			_ = d.Set("lb_preferred_method", prevLbPreferredMethod.(string))
 			_ = d.Set("lb_dynamic_ratio_preferred", prevLbDynamicRatioPreferred.(string))
 			_ = d.Set("lb_preferred_topology", prevLbPreferredTopology.(string))
@@ -695,7 +700,7 @@ func resourceDtcPoolUpdate(d *schema.ResourceData, m interface{}) error {
 		}
 	}()
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 	name := d.Get("name").(string)
 	comment := d.Get("comment").(string)
@@ -709,7 +714,7 @@ func resourceDtcPoolUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	serversInterface := d.Get("servers").([]interface{})
@@ -721,7 +726,7 @@ func resourceDtcPoolUpdate(d *schema.ResourceData, m interface{}) error {
 	lbDynamicRatioJson := d.Get("lb_dynamic_ratio_preferred").(string)
 	lbDynamicRatioPreferred, err := ConvertDynamicRatioPreferredToInterface(lbDynamicRatioJson, lbPreferredMethod, "")
 	if err != nil {
-		return fmt.Errorf("lb_dynamic_ratio_preferred : %s", err.Error())
+		return diagFromErr(fmt.Errorf("lb_dynamic_ratio_preferred : %w", err))
 	}
 	lbPreferredTopologyValue := d.Get("lb_preferred_topology").(string)
 	var lbPreferredTopology *string
@@ -733,7 +738,7 @@ func resourceDtcPoolUpdate(d *schema.ResourceData, m interface{}) error {
 	_, ok := d.GetOk("consolidated_monitors")
 	// if autoConsolidatedMonitors is True and consolidated_monitors is given in tf file, then return an error
 	if autoConsolidatedMonitors && ok && d.HasChange("consolidated_monitors") {
-		return fmt.Errorf("either consolidated_monitors or auto_consolidated_monitors should be set")
+		return diagFromErr(fmt.Errorf("either consolidated_monitors or auto_consolidated_monitors should be set"))
 	}
 	disable := d.Get("disable").(bool)
 	availability := d.Get("availability").(string)
@@ -745,22 +750,15 @@ func resourceDtcPoolUpdate(d *schema.ResourceData, m interface{}) error {
 	lbDynamicRatioAlternateJson := d.Get("lb_dynamic_ratio_alternate").(string)
 	lbDynamicRatioAlternate, err := ConvertDynamicRatioPreferredToInterface(lbDynamicRatioAlternateJson, lbAlternateMethod, lbAlternateMethod)
 	if err != nil {
-		return fmt.Errorf("lb_dynamic_ratio_alternate : %s", err.Error())
+		return diagFromErr(fmt.Errorf("lb_dynamic_ratio_alternate : %w", err))
 	}
 	quorum := uint32(d.Get("quorum").(int))
 
 	consolidatedMonitorsInterface := d.Get("consolidated_monitors").([]interface{})
 	consolidatedMonitors := convertInterfaceToList(consolidatedMonitorsInterface)
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var tenantID string
 	if tempVal, found := newExtAttrs[eaNameForTenantId]; found {
@@ -774,8 +772,8 @@ func resourceDtcPoolUpdate(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("DtcPool", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -783,11 +781,11 @@ func resourceDtcPoolUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal Dtc Pool : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal Dtc Pool : %w", err))
 	}
 	err = json.Unmarshal(recJson, &dtcPool)
 	if err != nil {
-		return fmt.Errorf("failed getting Dtc Pool : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting Dtc Pool : %w", err))
 	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
@@ -800,7 +798,7 @@ func resourceDtcPoolUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(dtcPool.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	// to unset consolidated_monitors, pass empty slice
 	_, isCmPresent := d.GetOk("consolidated_monitors")
@@ -816,24 +814,23 @@ func resourceDtcPoolUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	dtcPool, err = objMgr.UpdateDtcPool(d.Id(), comment, name, lbPreferredMethod, lbDynamicRatioPreferred, servers, monitors, lbPreferredTopology, lbAlternateMethod, lbAlternateTopology, lbDynamicRatioAlternate, newExtAttrs, autoConsolidatedMonitors, availability, consolidatedMonitors, ttl, useTtl, disable, quorum)
 	if err != nil {
-		return fmt.Errorf("error updating dtc-pool: %w", err)
+		return diagFromErr(fmt.Errorf("error updating dtc-pool: %w", err))
 	}
 	updateSuccessful = true
 	d.SetId(dtcPool.Ref)
 	if err = d.Set("ref", dtcPool.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	return resourceDtcPoolGet(d, m)
+	return resourceDtcPoolGet(ctx, d, m)
 }
 
-func resourceDtcPoolDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceDtcPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -848,8 +845,8 @@ func resourceDtcPoolDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("DtcPool", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -863,17 +860,16 @@ func resourceDtcPoolDelete(d *schema.ResourceData, m interface{}) error {
 
 	_, err = objMgr.DeleteDtcPool(dtcPool.Ref)
 	if err != nil {
-		return fmt.Errorf("deletion of Dtc Pool failed: %w", err)
+		return diagFromErr(fmt.Errorf("deletion of Dtc Pool failed: %w", err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceDtcPoolImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceDtcPoolImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -908,12 +904,12 @@ func resourceDtcPoolImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 	}
 
 	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
 		if err != nil {
 			return nil, err
 		}
 
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -1017,9 +1013,8 @@ func resourceDtcPoolImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 	}
 	d.SetId(obj.Ref)
 
-	err = resourceDtcPoolUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceDtcPoolUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 
 	return []*schema.ResourceData{d}, nil
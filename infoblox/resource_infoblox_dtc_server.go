@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"strings"
@@ -30,13 +31,14 @@ func convertDtcServerMonitorsToInterface(monitors []*ibclient.DtcServerMonitor,
 
 func resourceDtcServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceDtcServerCreate,
-		Read:   resourceDtcServerGet,
-		Update: resourceDtcServerUpdate,
-		Delete: resourceDtcServerDelete,
+		CreateContext: resourceDtcServerCreate,
+		ReadContext:   resourceDtcServerGet,
+		UpdateContext: resourceDtcServerUpdate,
+		DeleteContext: resourceDtcServerDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceDtcServerImport,
+			StateContext: resourceDtcServerImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -71,6 +73,7 @@ func resourceDtcServer() *schema.Resource {
 				Default:     "",
 				Description: "Extensible attributes of the  Dtc Server to be added/updated, as a map in JSON format",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 			"host": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -133,10 +136,10 @@ func resourceDtcServer() *schema.Resource {
 	}
 }
 
-func resourceDtcServerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceDtcServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	comment := d.Get("comment").(string)
@@ -146,13 +149,14 @@ func resourceDtcServerCreate(d *schema.ResourceData, m interface{}) error {
 	Disable := d.Get("disable").(bool)
 	sniHostname := d.Get("sni_hostname").(string)
 	useSniHostname := d.Get("use_sni_hostname").(bool)
-	extAttrJSON := d.Get("ext_attrs").(string)
 	monitors := d.Get("monitors").([]interface{})
 	dtcServerMonitor := convertInterfaceToList(monitors)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
+
+	extAttrs = withProviderEAs(extAttrs, m)
 	// Generate internal ID and add it to the extensible attributes
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -166,24 +170,23 @@ func resourceDtcServerCreate(d *schema.ResourceData, m interface{}) error {
 
 	newDtcServer, err := objMgr.CreateDtcServer(comment, name, host, AutoCreateHostRecord, Disable, extAttrs, dtcServerMonitor, sniHostname, useSniHostname)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(newDtcServer.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", newDtcServer.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	return resourceDtcServerGet(d, m)
+	return resourceDtcServerGet(ctx, d, m)
 }
 
-func resourceDtcServerGet(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
+func resourceDtcServerGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	extAttrs := make(map[string]interface{})
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	connector := m.(ibclient.IBConnector)
@@ -193,65 +196,65 @@ func resourceDtcServerGet(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		} else {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		}
 	}
 	var dtcServer *ibclient.DtcServer
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal DTC Server : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal DTC Server : %w", err))
 	}
 	err = json.Unmarshal(recJson, &dtcServer)
 	if err != nil {
-		return fmt.Errorf("failed getting DTC Server : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting DTC Server : %w", err))
 	}
 	delete(dtcServer.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(dtcServer.Ea, extAttrs)
+	omittedEAs := omitEAs(dtcServer.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("name", dtcServer.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("comment", dtcServer.Comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("disable", dtcServer.Disable); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("host", dtcServer.Host); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	monitorInterface := convertDtcServerMonitorsToInterface(dtcServer.Monitors, connector)
 	if err = d.Set("monitors", monitorInterface); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("auto_create_host_record", dtcServer.AutoCreateHostRecord); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("sni_hostname", dtcServer.SniHostname); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("use_sni_hostname", dtcServer.UseSniHostname); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", dtcServer.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(dtcServer.Ref)
 	return nil
 }
 
-func resourceDtcServerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceDtcServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		if !updateSuccessful {
@@ -259,6 +262,7 @@ func resourceDtcServerUpdate(d *schema.ResourceData, m interface{}) error {
 			prevComment, _ := d.GetChange("comment")
 			prevDisable, _ := d.GetChange("disable")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 			prevMonitors, _ := d.GetChange("monitors")
 			prevSniHostname, _ := d.GetChange("sni_hostname")
 			prevUseSniHostname, _ := d.GetChange("use_sni_hostname")
@@ -268,6 +272,7 @@ func resourceDtcServerUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("name", prevName.(string))
 			_ = d.Set("disable", prevDisable.(bool))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 			_ = d.Set("monitors", prevMonitors)
 			_ = d.Set("sni_hostname", prevSniHostname.(string))
 			_ = d.Set("use_sni_hostname", prevUseSniHostname.(bool))
@@ -284,16 +289,9 @@ func resourceDtcServerUpdate(d *schema.ResourceData, m interface{}) error {
 	useSniHostname := d.Get("use_sni_hostname").(bool)
 	monitors := d.Get("monitors").([]interface{})
 	dtcServerMonitor := convertInterfaceToList(monitors)
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var tenantID string
 	if tempVal, found := newExtAttrs[eaNameForTenantId]; found {
@@ -307,8 +305,8 @@ func resourceDtcServerUpdate(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("DtcServer", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -316,11 +314,11 @@ func resourceDtcServerUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal Dtc Server : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal Dtc Server : %w", err))
 	}
 	err = json.Unmarshal(recJson, &dtcServer)
 	if err != nil {
-		return fmt.Errorf("failed getting Dtc Server : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting Dtc Server : %w", err))
 	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
@@ -333,28 +331,27 @@ func resourceDtcServerUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(dtcServer.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	dtcServer, err = objMgr.UpdateDtcServer(d.Id(), comment, name, host, AutoCreateHostRecord, Disable, newExtAttrs, dtcServerMonitor, sniHostname, useSniHostname)
 	if err != nil {
-		return fmt.Errorf("error updating dtc-server: %w", err)
+		return diagFromErr(fmt.Errorf("error updating dtc-server: %w", err))
 	}
 	updateSuccessful = true
 	d.SetId(dtcServer.Ref)
 	if err = d.Set("ref", dtcServer.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	return resourceDtcServerGet(d, m)
+	return resourceDtcServerGet(ctx, d, m)
 }
 
-func resourceDtcServerDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceDtcServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -369,8 +366,8 @@ func resourceDtcServerDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("DtcServer", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -384,16 +381,15 @@ func resourceDtcServerDelete(d *schema.ResourceData, m interface{}) error {
 
 	_, err = objMgr.DeleteDtcServer(DtcServer.Ref)
 	if err != nil {
-		return fmt.Errorf("deletion of Dtc Server failed: %w", err)
+		return diagFromErr(fmt.Errorf("deletion of Dtc Server failed: %w", err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceDtcServerImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceDtcServerImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -417,12 +413,12 @@ func resourceDtcServerImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 	}
 
 	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
 		if err != nil {
 			return nil, err
 		}
 
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -454,9 +450,8 @@ func resourceDtcServerImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 	}
 
 	d.SetId(obj.Ref)
-	err = resourceDtcServerUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceDtcServerUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"reflect"
@@ -12,13 +13,14 @@ import (
 
 func resourceFixedRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceFixedRecordCreate,
-		Read:   resourceFixedRecordRead,
-		Update: resourceFixedRecordUpdate,
-		Delete: resourceFixedRecordDelete,
+		CreateContext: resourceFixedRecordCreate,
+		ReadContext:   resourceFixedRecordRead,
+		UpdateContext: resourceFixedRecordUpdate,
+		DeleteContext: resourceFixedRecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceFixedRecordImport,
+			StateContext: resourceFixedRecordImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -67,6 +69,18 @@ func resourceFixedRecord() *schema.Resource {
 				Default:     "",
 				Description: "Extensible attributes of the A-record to be added/updated, as a map in JSON format",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"inherit_ext_attrs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Keep the extensible attributes, inherited from the parent network, inherited, instead of converting them to local values on update.",
+			},
+			"inherited_ext_attrs": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The extensible attributes inherited from the parent object, as a map in JSON format. Set when 'inherit_ext_attrs' is true.",
+			},
 			"ipv4addr": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -236,10 +250,10 @@ func resourceFixedRecord() *schema.Resource {
 		},
 	}
 }
-func resourceFixedRecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourceFixedRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	agentCircuitId := d.Get("agent_circuit_id").(string)
 	agentRemoteId := d.Get("agent_remote_id").(string)
@@ -252,27 +266,28 @@ func resourceFixedRecordCreate(d *schema.ResourceData, m interface{}) error {
 	mac := d.Get("mac").(string)
 	matchClient := d.Get("match_client").(string)
 	if matchClient == "MAC_ADDRESS" && mac == "" {
-		return fmt.Errorf("MAC address is required when match_client set to MAC_ADDRESS")
+		return diagFromErr(fmt.Errorf("MAC address is required when match_client set to MAC_ADDRESS"))
 	}
 	name := d.Get("name").(string)
 	network := d.Get("network").(string)
 	if ipAddr == "" && network == "" {
-		return fmt.Errorf("either 'ipv4addr' or 'network' fields needs to provided to allocate a fixed address")
+		return diagFromErr(fmt.Errorf("either 'ipv4addr' or 'network' fields needs to provided to allocate a fixed address"))
 	}
 	networkView := d.Get("network_view").(string)
 
 	optionsInterface := d.Get("options").([]interface{})
 	options, err := validateDhcpOptions(optionsInterface)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	useOptions := d.Get("use_options").(bool)
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	// Generate internal ID and add it to the extensible attributes
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -287,29 +302,28 @@ func resourceFixedRecordCreate(d *schema.ResourceData, m interface{}) error {
 
 	fixedAddress, err := objMgr.AllocateIP(networkView, network, ipAddr, false, mac, name, comment, extAttrs, matchClient, agentCircuitId, agentRemoteId, clientIdentifierPrependZero, dhcpClientIdentifier, disable, options, useOptions)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(fixedAddress.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", fixedAddress.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	return resourceFixedRecordRead(d, m)
+	return resourceFixedRecordRead(ctx, d, m)
 }
-func resourceFixedRecordRead(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceFixedRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rec, err := searchObjectByRefOrInternalId("FixedAddress", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -318,95 +332,99 @@ func resourceFixedRecordRead(d *schema.ResourceData, m interface{}) error {
 	var fixedAddress *ibclient.FixedAddress
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal fixed address : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal fixed address : %w", err))
 	}
 	err = json.Unmarshal(recJson, &fixedAddress)
 	if err != nil {
-		return fmt.Errorf("failed getting fixed address : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting fixed address : %w", err))
 	}
 
 	delete(fixedAddress.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(fixedAddress.Ea, extAttrs)
+	if err = readInheritedEAs(d, m.(ibclient.IBConnector), fixedAddress.Ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	omittedEAs := omitEAs(fixedAddress.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 	if err = d.Set("comment", fixedAddress.Comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("disable", fixedAddress.Disable); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ipv4addr", fixedAddress.IPv4Address); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if fixedAddress.MatchClient != nil && (*fixedAddress.MatchClient == "MAC_ADDRESS" || *fixedAddress.MatchClient == "RESERVED") {
 		if err = d.Set("mac", fixedAddress.Mac); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if err = d.Set("match_client", fixedAddress.MatchClient); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("name", fixedAddress.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("network", fixedAddress.Cidr); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("network_view", fixedAddress.NetviewName); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if fixedAddress.MatchClient != nil && *fixedAddress.MatchClient == "CIRCUIT_ID" {
 		if err = d.Set("agent_circuit_id", fixedAddress.AgentCircuitId); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err = d.Set("agent_circuit_id", ""); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if fixedAddress.MatchClient != nil && *fixedAddress.MatchClient == "REMOTE_ID" {
 		if err = d.Set("agent_remote_id", fixedAddress.AgentRemoteId); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err = d.Set("agent_remote_id", ""); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if fixedAddress.MatchClient != nil && *fixedAddress.MatchClient == "CLIENT_ID" {
 		if err = d.Set("client_identifier_prepend_zero", fixedAddress.ClientIdentifierPrependZero); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		if err = d.Set("dhcp_client_identifier", fixedAddress.DhcpClientIdentifier); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err = d.Set("client_identifier_prepend_zero", false); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		if err = d.Set("dhcp_client_identifier", ""); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if err = d.Set("use_options", fixedAddress.UseOptions); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("options", convertDhcpOptionsToInterface(fixedAddress.Options)); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(fixedAddress.Ref)
 	return nil
 }
-func resourceFixedRecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceFixedRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		if !updateSuccessful {
@@ -428,6 +446,7 @@ func resourceFixedRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevUseOption, _ := d.GetChange("use_options")
 			prevOptions, _ := d.GetChange("options")
 			prevExtAttrsJSON, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("disable", prevDisable.(bool))
@@ -444,14 +463,15 @@ func resourceFixedRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("use_options", prevUseOption.(bool))
 			_ = d.Set("options", prevOptions)
 			_ = d.Set("ext_attrs", prevExtAttrsJSON.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 
 		}
 	}()
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'network_view' field is not allowed"))
 	}
 
 	network := d.Get("network").(string)
@@ -473,16 +493,9 @@ func resourceFixedRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	clientIdentifierPrependZero := &clientIdentifierPrependZeroBool
 	dhcpClientIdentifier := d.Get("dhcp_client_identifier").(string)
 	useOptions := d.Get("use_options").(bool)
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -497,8 +510,8 @@ func resourceFixedRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("FixedAddress", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -506,11 +519,11 @@ func resourceFixedRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal fixedAddress : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal fixedAddress : %w", err))
 	}
 	err = json.Unmarshal(recJson, &fixedAddress)
 	if err != nil {
-		return fmt.Errorf("failed getting fixed addresss: %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting fixed addresss: %w", err))
 	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
@@ -529,34 +542,36 @@ func resourceFixedRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	newList, okNew := newOptions.([]interface{})
 
 	if !okOld || !okNew {
-		return fmt.Errorf("options is not a slice of interfaces")
+		return diagFromErr(fmt.Errorf("options is not a slice of interfaces"))
 	}
 
 	optimizedOptions := optimizeDhcpOptions(oldList, newList)
 	options, err := validateDhcpOptions(optimizedOptions)
 	if err != nil {
-		return fmt.Errorf("failed to validate options: %w", err)
+		return diagFromErr(fmt.Errorf("failed to validate options: %w", err))
 	}
 
 	fixedAddress, err = objMgr.UpdateFixedAddress(d.Id(), networkView, name, network, ipv4addr, matchClient, mac, comment, newExtAttrs, agentCircuitId, agentRemoteId, clientIdentifierPrependZero, dhcpClientIdentifier, disable, options, useOptions)
 	if err != nil {
-		return fmt.Errorf("error updating Fixed address: %w", err)
+		return diagFromErr(fmt.Errorf("error updating Fixed address: %w", err))
+	}
+	if err = updateEAInheritance(d, m, fixedAddress.Ref, newExtAttrs, false); err != nil {
+		return diagFromErr(err)
 	}
 	updateSuccessful = true
 	d.SetId(fixedAddress.Ref)
 	if err = d.Set("ref", fixedAddress.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	return resourceFixedRecordRead(d, m)
+	return resourceFixedRecordRead(ctx, d, m)
 }
-func resourceFixedRecordDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceFixedRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -571,8 +586,8 @@ func resourceFixedRecordDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("FixedAddress", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -586,16 +601,15 @@ func resourceFixedRecordDelete(d *schema.ResourceData, m interface{}) error {
 
 	_, err = objMgr.DeleteARecord(fixedAddress.Ref)
 	if err != nil {
-		return fmt.Errorf("deletion of Fixed address failed: %w", err)
+		return diagFromErr(fmt.Errorf("deletion of Fixed address failed: %w", err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceFixedRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceFixedRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -619,12 +633,19 @@ func resourceFixedRecordImport(d *schema.ResourceData, m interface{}) ([]*schema
 	if err = d.Set("disable", obj.Disable); err != nil {
 		return nil, err
 	}
+	// Inherited EAs are not managed by the resource.
+	inheritedEAs, err := getInheritedEAs(m.(ibclient.IBConnector), obj.Ref)
+	if err != nil {
+		return nil, err
+	}
+	obj.Ea = withoutInheritedEAs(obj.Ea, inheritedEAs)
+
 	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
 		if err != nil {
 			return nil, err
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -668,9 +689,8 @@ func resourceFixedRecordImport(d *schema.ResourceData, m interface{}) ([]*schema
 	d.SetId(obj.Ref)
 
 	// Update the resource with the EA Terraform Internal ID
-	err = resourceFixedRecordUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceFixedRecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
@@ -0,0 +1,875 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// hostAddressOptionsSchema describes the DHCP options of a host address, defined in the option space vendorClass by default.
+func hostAddressOptionsSchema(vendorClass string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Description: "DHCP options of the host address. An option sets the value of a DHCP option that has been defined " +
+			"in an option space. When defining a DHCP option, at least a ‘name’ or a ‘num’ is required.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of the DHCP option.",
+				},
+				"num": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The code of the DHCP option.",
+				},
+				"use_option": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+					Description: "Only applies to special options that are displayed separately from other options and have a use flag. " +
+						"These options are: `routers`, `router-templates`, `domain-name-servers`, `domain-name`, `broadcast-address`, " +
+						"`broadcast-address-offset`, `dhcp-lease-time`, `dhcp6.name-servers`",
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Value of the DHCP option.",
+				},
+				"vendor_class": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     vendorClass,
+					Description: "The name of the space this DHCP option is associated to.",
+				},
+			},
+		},
+	}
+}
+
+func resourceHostRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHostRecordCreate,
+		ReadContext:   resourceHostRecordRead,
+		UpdateContext: resourceHostRecordUpdate,
+		DeleteContext: resourceHostRecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHostRecordImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The host name of the host record, in FQDN format.",
+			},
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone of the host record belongs to.",
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "Network view which the addresses of the host record belong to.",
+			},
+			"enable_dns": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag that defines if the host record is to be used for DNS purposes.",
+			},
+			"ipv4_address": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "IPv4 addresses of the host record, one block per address.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ipv4_addr": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							Description: "IPv4 address of the host. Leave empty to allocate the next available address " +
+								"from 'cidr' or from a network, found by 'filter_params'.",
+						},
+						"cidr": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The network, in CIDR format, to allocate the next available IPv4 address from.",
+						},
+						"filter_params": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Extensible attributes of the network to allocate the next available IPv4 address from, " +
+								"as a map in JSON format.",
+						},
+						"mac": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "MAC address of the host address. Required when 'enable_dhcp' is true.",
+							DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+								return strings.EqualFold(oldValue, newValue)
+							},
+						},
+						"enable_dhcp": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Set to true to enable the DHCP configuration of the host address.",
+						},
+						"options": hostAddressOptionsSchema("DHCP"),
+						"use_options": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Use option is a flag that indicates whether the options field are used or not.",
+						},
+					},
+				},
+			},
+			"ipv6_address": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "IPv6 addresses of the host record, one block per address.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ipv6_addr": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							Description: "IPv6 address of the host. Leave empty to allocate the next available address " +
+								"from 'cidr' or from a network, found by 'filter_params'.",
+							StateFunc: func(val interface{}) string {
+								if val == "" {
+									return ""
+								}
+								return normalizeIPAddress(val)
+							},
+						},
+						"cidr": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The network, in CIDR format, to allocate the next available IPv6 address from.",
+							StateFunc: func(val interface{}) string {
+								if val == "" {
+									return ""
+								}
+								return normalizeIPAddress(val)
+							},
+						},
+						"filter_params": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Extensible attributes of the network to allocate the next available IPv6 address from, " +
+								"as a map in JSON format.",
+						},
+						"duid": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "DHCPv6 Unique Identifier (DUID) of the host address. Required when 'enable_dhcp' is true.",
+						},
+						"enable_dhcp": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Set to true to enable the DHCP configuration of the host address.",
+						},
+						"options": hostAddressOptionsSchema("DHCPv6"),
+						"use_options": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Use option is a flag that indicates whether the options field are used or not.",
+						},
+					},
+				},
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL attribute value for the record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the host record.",
+			},
+			"aliases": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Aliases of the host record.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					if newValue == "0" {
+						return false
+					}
+					if oldValue == newValue {
+						return true
+					}
+					enableDNS := d.Get("enable_dns").(bool)
+					fqdn := d.Get("fqdn").(string)
+					domain := strings.Join(strings.Split(fqdn, ".")[1:], ".")
+					oldAliases, newAliases := d.GetChange("aliases")
+					oldAliasesNew := normalizeAndSortAliases(oldAliases.([]interface{}), domain, enableDNS)
+					newAliasesNew := normalizeAndSortAliases(newAliases.([]interface{}), domain, enableDNS)
+					return strings.Join(oldAliasesNew, ",") == strings.Join(newAliasesNew, ",")
+				},
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disables the host record if set to 'true'.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the host record, as a map in JSON format",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+// hostAddrAllocation returns the value of the address of a host address block, which allocates the next available
+// address from the block's CIDR or from the first network with the extensible attributes set by 'filter_params'.
+func hostAddrAllocation(conn ibclient.IBConnector, block map[string]interface{}, netView string, isIPv6 bool) (string, error) {
+	cidr := block["cidr"].(string)
+	filterParams := block["filter_params"].(string)
+	if cidr == "" && filterParams == "" {
+		return "", fmt.Errorf("either an address, 'cidr' or 'filter_params' is required for a host address")
+	}
+	if cidr == "" {
+		var eaMap map[string]string
+		if err := json.Unmarshal([]byte(filterParams), &eaMap); err != nil {
+			return "", fmt.Errorf("error unmarshalling extensible attributes of the network: %w", err)
+		}
+		sf := map[string]string{"network_view": netView}
+		for name, value := range eaMap {
+			sf[fmt.Sprintf("*%s", name)] = value
+		}
+		var networks []ibclient.Network
+		err := conn.GetObject(ibclient.NewNetwork(netView, "", isIPv6, "", nil), "", ibclient.NewQueryParams(false, sf), &networks)
+		if err != nil {
+			return "", fmt.Errorf("failed to find a network by 'filter_params': %w", err)
+		}
+		if len(networks) == 0 {
+			return "", fmt.Errorf("no network is found in network view '%s' by 'filter_params' %s", netView, filterParams)
+		}
+		cidr = networks[0].Cidr
+	}
+
+	return fmt.Sprintf("func:nextavailableip:%s,%s", cidr, netView), nil
+}
+
+// hostAddrReallocated tells if the address of a host address block is to be allocated again on update,
+// since its CIDR or 'filter_params' have changed while the address has been kept from the previous allocation.
+func hostAddrReallocated(block map[string]interface{}, oldBlocks []interface{}, i int, addrField string) bool {
+	if i >= len(oldBlocks) {
+		return false
+	}
+	oldBlock := oldBlocks[i].(map[string]interface{})
+	if oldBlock["cidr"] == block["cidr"] && oldBlock["filter_params"] == block["filter_params"] {
+		return false
+	}
+
+	return block[addrField] == oldBlock[addrField]
+}
+
+// hostRecordIpv4Addrs makes the IPv4 addresses of a host record from the resource's 'ipv4_address' blocks.
+func hostRecordIpv4Addrs(d *schema.ResourceData, conn ibclient.IBConnector, netView string) ([]ibclient.HostRecordIpv4Addr, error) {
+	oldBlocks, newBlocks := d.GetChange("ipv4_address")
+	addrs := make([]ibclient.HostRecordIpv4Addr, 0, len(newBlocks.([]interface{})))
+	for i, b := range newBlocks.([]interface{}) {
+		block := b.(map[string]interface{})
+		ipAddr := block["ipv4_addr"].(string)
+		if ipAddr == "" || (!d.IsNewResource() && hostAddrReallocated(block, oldBlocks.([]interface{}), i, "ipv4_addr")) {
+			var err error
+			if ipAddr, err = hostAddrAllocation(conn, block, netView, false); err != nil {
+				return nil, err
+			}
+		}
+		options, err := validateDhcpOptions(block["options"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		enableDhcp := block["enable_dhcp"].(bool)
+		useOptions := block["use_options"].(bool)
+		addr := ibclient.HostRecordIpv4Addr{
+			Ipv4Addr:   &ipAddr,
+			EnableDhcp: &enableDhcp,
+			Options:    options,
+			UseOptions: &useOptions,
+		}
+		if mac := block["mac"].(string); mac != "" {
+			addr.Mac = &mac
+		} else if enableDhcp {
+			return nil, fmt.Errorf("'mac' is required for the IPv4 address of the host record when 'enable_dhcp' is true")
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
+
+// hostRecordIpv6Addrs makes the IPv6 addresses of a host record from the resource's 'ipv6_address' blocks.
+func hostRecordIpv6Addrs(d *schema.ResourceData, conn ibclient.IBConnector, netView string) ([]ibclient.HostRecordIpv6Addr, error) {
+	oldBlocks, newBlocks := d.GetChange("ipv6_address")
+	addrs := make([]ibclient.HostRecordIpv6Addr, 0, len(newBlocks.([]interface{})))
+	for i, b := range newBlocks.([]interface{}) {
+		block := b.(map[string]interface{})
+		ipAddr := block["ipv6_addr"].(string)
+		if ipAddr == "" || (!d.IsNewResource() && hostAddrReallocated(block, oldBlocks.([]interface{}), i, "ipv6_addr")) {
+			var err error
+			if ipAddr, err = hostAddrAllocation(conn, block, netView, true); err != nil {
+				return nil, err
+			}
+		}
+		options, err := validateDhcpOptions(block["options"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		enableDhcp := block["enable_dhcp"].(bool)
+		useOptions := block["use_options"].(bool)
+		addr := ibclient.HostRecordIpv6Addr{
+			Ipv6Addr:   &ipAddr,
+			EnableDhcp: &enableDhcp,
+			Options:    options,
+			UseOptions: &useOptions,
+		}
+		if duid := block["duid"].(string); duid != "" {
+			addr.Duid = &duid
+		} else if enableDhcp {
+			return nil, fmt.Errorf("'duid' is required for the IPv6 address of the host record when 'enable_dhcp' is true")
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
+
+// newHostRecordFromResource makes a host record of the resource's fields, except for the extensible attributes.
+func newHostRecordFromResource(d *schema.ResourceData, conn ibclient.IBConnector) (*ibclient.HostRecord, error) {
+	netView := d.Get("network_view").(string)
+	ipv4Addrs, err := hostRecordIpv4Addrs(d, conn, netView)
+	if err != nil {
+		return nil, err
+	}
+	ipv6Addrs, err := hostRecordIpv6Addrs(d, conn, netView)
+	if err != nil {
+		return nil, err
+	}
+	if len(ipv4Addrs) == 0 && len(ipv6Addrs) == 0 {
+		return nil, fmt.Errorf("at least one 'ipv4_address' or 'ipv6_address' block is required for the host record")
+	}
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return nil, fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	aliases := d.Get("aliases").([]interface{})
+	aliasStrs := make([]string, len(aliases))
+	for i, alias := range aliases {
+		aliasStrs[i] = alias.(string)
+	}
+
+	enableDns := d.Get("enable_dns").(bool)
+	dnsView := d.Get("dns_view").(string)
+	if !enableDns {
+		dnsView = ""
+	}
+
+	return ibclient.NewHostRecord(
+		netView, d.Get("fqdn").(string), "", "", ipv4Addrs, ipv6Addrs, nil, enableDns, dnsView, "", "",
+		useTtl, ttl, d.Get("comment").(string), aliasStrs, d.Get("disable").(bool)), nil
+}
+
+// getHostRecord reads a host record along with the DHCP options of its addresses,
+// which NIOS returns only for the host addresses themselves.
+func getHostRecord(conn ibclient.IBConnector, ref string) (*ibclient.HostRecord, error) {
+	hostRec := ibclient.NewEmptyHostRecord()
+	hostRec.SetReturnFields(append(hostRec.ReturnFields(), "disable"))
+	if err := conn.GetObject(hostRec, ref, ibclient.NewQueryParams(false, nil), hostRec); err != nil {
+		return nil, err
+	}
+
+	for i := range hostRec.Ipv4Addrs {
+		addr := ibclient.NewEmptyHostRecordIpv4Addr()
+		addr.SetReturnFields([]string{"ipv4addr", "mac", "configure_for_dhcp", "options", "use_options"})
+		if err := conn.GetObject(addr, hostRec.Ipv4Addrs[i].Ref, ibclient.NewQueryParams(false, nil), addr); err != nil {
+			return nil, fmt.Errorf("failed to get the IPv4 address of the host record: %w", err)
+		}
+		hostRec.Ipv4Addrs[i].Options = addr.Options
+		hostRec.Ipv4Addrs[i].UseOptions = addr.UseOptions
+	}
+	for i := range hostRec.Ipv6Addrs {
+		addr := ibclient.NewEmptyHostRecordIpv6Addr()
+		addr.SetReturnFields([]string{"ipv6addr", "duid", "configure_for_dhcp", "options", "use_options"})
+		if err := conn.GetObject(addr, hostRec.Ipv6Addrs[i].Ref, ibclient.NewQueryParams(false, nil), addr); err != nil {
+			return nil, fmt.Errorf("failed to get the IPv6 address of the host record: %w", err)
+		}
+		hostRec.Ipv6Addrs[i].Options = addr.Options
+		hostRec.Ipv6Addrs[i].UseOptions = addr.UseOptions
+	}
+
+	return hostRec, nil
+}
+
+// hostAddrOptions converts the DHCP options of a host address, omitting the default lease time option,
+// which NIOS sets for an address unless the block sets it itself.
+func hostAddrOptions(options []*ibclient.Dhcpoption, block map[string]interface{}) []interface{} {
+	configured := false
+	if block != nil {
+		for _, opt := range block["options"].([]interface{}) {
+			if optMap, ok := opt.(map[string]interface{}); ok && optMap["name"] == "dhcp-lease-time" {
+				configured = true
+			}
+		}
+	}
+
+	res := make([]interface{}, 0, len(options))
+	for _, option := range options {
+		optMap := map[string]interface{}{
+			"name":         option.Name,
+			"num":          int(option.Num),
+			"value":        option.Value,
+			"vendor_class": option.VendorClass,
+			"use_option":   option.UseOption,
+		}
+		if !configured && isDefault(optMap) {
+			continue
+		}
+		res = append(res, optMap)
+	}
+
+	return res
+}
+
+// hostAddrBlocks orders the addresses of a host record as the resource's blocks do, so that an address keeps
+// its block; the addresses, which are not in the blocks, follow them.
+func hostAddrBlocks(blocks []interface{}, addrs []string, addrField string) []int {
+	order := make([]int, 0, len(addrs))
+	used := make([]bool, len(addrs))
+	for _, b := range blocks {
+		block, _ := b.(map[string]interface{})
+		if block == nil {
+			continue
+		}
+		for i, addr := range addrs {
+			if !used[i] && addr != "" && normalizeIPAddress(addr) == normalizeIPAddress(block[addrField]) {
+				order = append(order, i)
+				used[i] = true
+				break
+			}
+		}
+	}
+	for i := range addrs {
+		if !used[i] {
+			order = append(order, i)
+		}
+	}
+
+	return order
+}
+
+func blockByAddr(blocks []interface{}, addrField string, addr string) map[string]interface{} {
+	for _, b := range blocks {
+		if block, ok := b.(map[string]interface{}); ok && normalizeIPAddress(block[addrField]) == normalizeIPAddress(addr) {
+			return block
+		}
+	}
+
+	return nil
+}
+
+func setHostRecordAddrs(d *schema.ResourceData, hostRec *ibclient.HostRecord) error {
+	oldBlocks := d.Get("ipv4_address").([]interface{})
+	ipv4Addrs := make([]string, len(hostRec.Ipv4Addrs))
+	for i, addr := range hostRec.Ipv4Addrs {
+		ipv4Addrs[i] = stringPtrValue(addr.Ipv4Addr)
+	}
+	ipv4Blocks := make([]interface{}, 0, len(ipv4Addrs))
+	for _, i := range hostAddrBlocks(oldBlocks, ipv4Addrs, "ipv4_addr") {
+		addr := hostRec.Ipv4Addrs[i]
+		block := map[string]interface{}{
+			"ipv4_addr":     ipv4Addrs[i],
+			"cidr":          "",
+			"filter_params": "",
+			"mac":           stringPtrValue(addr.Mac),
+			"enable_dhcp":   addr.EnableDhcp != nil && *addr.EnableDhcp,
+			"use_options":   addr.UseOptions != nil && *addr.UseOptions,
+		}
+		oldBlock := blockByAddr(oldBlocks, "ipv4_addr", ipv4Addrs[i])
+		if oldBlock != nil {
+			block["cidr"] = oldBlock["cidr"]
+			block["filter_params"] = oldBlock["filter_params"]
+		}
+		block["options"] = hostAddrOptions(addr.Options, oldBlock)
+		ipv4Blocks = append(ipv4Blocks, block)
+	}
+	if err := d.Set("ipv4_address", ipv4Blocks); err != nil {
+		return err
+	}
+
+	oldBlocks = d.Get("ipv6_address").([]interface{})
+	ipv6Addrs := make([]string, len(hostRec.Ipv6Addrs))
+	for i, addr := range hostRec.Ipv6Addrs {
+		ipv6Addrs[i] = stringPtrValue(addr.Ipv6Addr)
+	}
+	ipv6Blocks := make([]interface{}, 0, len(ipv6Addrs))
+	for _, i := range hostAddrBlocks(oldBlocks, ipv6Addrs, "ipv6_addr") {
+		addr := hostRec.Ipv6Addrs[i]
+		block := map[string]interface{}{
+			"ipv6_addr":     ipv6Addrs[i],
+			"cidr":          "",
+			"filter_params": "",
+			"duid":          stringPtrValue(addr.Duid),
+			"enable_dhcp":   addr.EnableDhcp != nil && *addr.EnableDhcp,
+			"use_options":   addr.UseOptions != nil && *addr.UseOptions,
+		}
+		oldBlock := blockByAddr(oldBlocks, "ipv6_addr", ipv6Addrs[i])
+		if oldBlock != nil {
+			block["cidr"] = oldBlock["cidr"]
+			block["filter_params"] = oldBlock["filter_params"]
+		}
+		block["options"] = hostAddrOptions(addr.Options, oldBlock)
+		ipv6Blocks = append(ipv6Blocks, block)
+	}
+
+	return d.Set("ipv6_address", ipv6Blocks)
+}
+
+func setHostRecordFields(d *schema.ResourceData, hostRec *ibclient.HostRecord) error {
+	if err := setHostRecordAddrs(d, hostRec); err != nil {
+		return err
+	}
+	if err := d.Set("fqdn", hostRec.Name); err != nil {
+		return err
+	}
+	if err := d.Set("network_view", hostRec.NetworkView); err != nil {
+		return err
+	}
+	enableDns := hostRec.EnableDns == nil || *hostRec.EnableDns
+	if err := d.Set("enable_dns", enableDns); err != nil {
+		return err
+	}
+	if enableDns {
+		if err := d.Set("dns_view", hostRec.View); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("comment", stringPtrValue(hostRec.Comment)); err != nil {
+		return err
+	}
+	if err := d.Set("disable", hostRec.Disable != nil && *hostRec.Disable); err != nil {
+		return err
+	}
+
+	ttl := ttlUndef
+	if hostRec.UseTtl != nil && *hostRec.UseTtl && hostRec.Ttl != nil {
+		ttl = int(*hostRec.Ttl)
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
+	aliases := make([]interface{}, len(hostRec.Aliases))
+	for i, a := range hostRec.Aliases {
+		aliases[i] = a
+	}
+
+	return d.Set("aliases", aliases)
+}
+
+func resourceHostRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	hostRec, err := newHostRecordFromResource(d, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	hostRec.Ea = extAttrs
+
+	ref, err := connector.CreateObject(hostRec)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error while creating a host record: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceHostRecordRead(ctx, d, m)
+}
+
+func resourceHostRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	rec, err := searchObjectByRefOrInternalId("HostRecord", d, m)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+	var found *ibclient.HostRecord
+	recJson, err := json.Marshal(rec)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to marshal host record: %w", err))
+	}
+	if err = json.Unmarshal(recJson, &found); err != nil {
+		return diagFromErr(fmt.Errorf("failed getting host record: %w", err))
+	}
+
+	hostRec, err := getHostRecord(m.(ibclient.IBConnector), found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed getting host record: %w", err))
+	}
+
+	delete(hostRec.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(hostRec.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setHostRecordFields(d, hostRec); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", hostRec.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(hostRec.Ref)
+
+	return nil
+}
+
+func resourceHostRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		if !updateSuccessful {
+			// Reverting the state back, in case of a failure,
+			// otherwise Terraform will keep the values, which leaded to the failure,
+			// in the state file.
+			prevFqdn, _ := d.GetChange("fqdn")
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevNetView, _ := d.GetChange("network_view")
+			prevEnableDns, _ := d.GetChange("enable_dns")
+			prevIpv4Addrs, _ := d.GetChange("ipv4_address")
+			prevIpv6Addrs, _ := d.GetChange("ipv6_address")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevAliases, _ := d.GetChange("aliases")
+			prevDisable, _ := d.GetChange("disable")
+			prevExtAttrsJSON, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("fqdn", prevFqdn.(string))
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("network_view", prevNetView.(string))
+			_ = d.Set("enable_dns", prevEnableDns.(bool))
+			_ = d.Set("ipv4_address", prevIpv4Addrs)
+			_ = d.Set("ipv6_address", prevIpv6Addrs)
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("aliases", prevAliases)
+			_ = d.Set("disable", prevDisable.(bool))
+			_ = d.Set("ext_attrs", prevExtAttrsJSON.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+	if d.HasChange("network_view") {
+		return diagFromErr(fmt.Errorf("changing the value of 'network_view' field is not allowed"))
+	}
+	if d.HasChange("dns_view") {
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
+	}
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	rec, err := searchObjectByRefOrInternalId("HostRecord", d, m)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+	var found *ibclient.HostRecord
+	recJson, err := json.Marshal(rec)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to marshal host record: %w", err))
+	}
+	if err = json.Unmarshal(recJson, &found); err != nil {
+		return diagFromErr(fmt.Errorf("failed getting host record: %w", err))
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	newExtAttrs, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	hostRec, err := newHostRecordFromResource(d, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	// The network view of a host record is not updatable.
+	hostRec.NetworkView = ""
+	hostRec.Ea = newExtAttrs
+
+	ref, err := connector.UpdateObject(hostRec, found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error updating host record: %w", err))
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceHostRecordRead(ctx, d, m)
+}
+
+func resourceHostRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	rec, err := searchObjectByRefOrInternalId("HostRecord", d, m)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+	var hostRec *ibclient.HostRecord
+	recJson, _ := json.Marshal(rec)
+	if err = json.Unmarshal(recJson, &hostRec); err != nil {
+		return diagFromErr(fmt.Errorf("failed getting host record: %w", err))
+	}
+
+	if _, err = m.(ibclient.IBConnector).DeleteObject(hostRec.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("deletion of host record failed: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceHostRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	hostRec, err := getHostRecord(m.(ibclient.IBConnector), d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("failed getting host record: %w", err)
+	}
+
+	delete(hostRec.Ea, eaNameForInternalId)
+	if hostRec.Ea != nil && len(hostRec.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(hostRec.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setHostRecordFields(d, hostRec); err != nil {
+		return nil, err
+	}
+	d.SetId(hostRec.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceHostRecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
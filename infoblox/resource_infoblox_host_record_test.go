@@ -0,0 +1,147 @@
+package infoblox
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckHostRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_host_record" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		objMgr := ibclient.NewObjectManager(connector, "terraform_test", "test")
+		rec, _ := objMgr.GetHostRecordByRef(rs.Primary.ID)
+		if rec != nil && rec.Ref != "" {
+			return fmt.Errorf("host record %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestHostAddrBlocks(t *testing.T) {
+	blocks := []interface{}{
+		map[string]interface{}{"ipv4_addr": "10.0.0.3"},
+		map[string]interface{}{"ipv4_addr": "10.0.0.1"},
+	}
+
+	// The addresses keep the order of the blocks, the new ones follow them.
+	order := hostAddrBlocks(blocks, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, "ipv4_addr")
+	if !reflect.DeepEqual(order, []int{2, 0, 1}) {
+		t.Fatalf("unexpected order of the addresses: %v", order)
+	}
+}
+
+func TestHostAddrOptions(t *testing.T) {
+	options := []*ibclient.Dhcpoption{
+		{Name: "dhcp-lease-time", Num: 51, Value: "43200", VendorClass: "DHCP"},
+		{Name: "routers", Num: 3, Value: "10.0.0.1", VendorClass: "DHCP", UseOption: true},
+	}
+
+	// The default lease time is omitted, unless the block sets it.
+	res := hostAddrOptions(options, map[string]interface{}{"options": []interface{}{}})
+	if len(res) != 1 || res[0].(map[string]interface{})["name"] != "routers" {
+		t.Fatalf("unexpected options: %v", res)
+	}
+	res = hostAddrOptions(options, map[string]interface{}{"options": []interface{}{
+		map[string]interface{}{"name": "dhcp-lease-time"},
+	}})
+	if len(res) != 2 {
+		t.Fatalf("unexpected options: %v", res)
+	}
+}
+
+func TestAccResourceHostRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHostRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "net1" {
+						cidr = "17.0.0.0/24"
+					}
+					resource "infoblox_ipv6_network" "net2" {
+						cidr = "2001:db8:17::/64"
+					}
+					resource "infoblox_host_record" "host1" {
+						fqdn       = "host1.test.com"
+						enable_dns = false
+						comment    = "host record with several addresses"
+
+						ipv4_address {
+							ipv4_addr   = "17.0.0.10"
+							mac         = "11:22:33:44:55:66"
+							enable_dhcp = true
+							use_options = true
+							options {
+								name         = "routers"
+								num          = 3
+								value        = "17.0.0.1"
+								vendor_class = "DHCP"
+								use_option   = true
+							}
+						}
+						ipv4_address {
+							cidr = infoblox_ipv4_network.net1.cidr
+						}
+						ipv6_address {
+							cidr = infoblox_ipv6_network.net2.cidr
+							duid = "00:01:00:01:2a:3b:4c:5d:11:22:33:44:55:66"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_host_record.host1", "fqdn", "host1.test.com"),
+					resource.TestCheckResourceAttr("infoblox_host_record.host1", "ipv4_address.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_host_record.host1", "ipv4_address.0.ipv4_addr", "17.0.0.10"),
+					resource.TestCheckResourceAttr("infoblox_host_record.host1", "ipv4_address.0.enable_dhcp", "true"),
+					resource.TestCheckResourceAttr("infoblox_host_record.host1", "ipv4_address.0.options.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_host_record.host1", "ipv4_address.0.options.0.value", "17.0.0.1"),
+					resource.TestCheckResourceAttr("infoblox_host_record.host1", "ipv4_address.1.ipv4_addr", "17.0.0.1"),
+					resource.TestCheckResourceAttr("infoblox_host_record.host1", "ipv6_address.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_host_record.host1", "ipv6_address.0.ipv6_addr", "2001:db8:17::1"),
+				),
+			},
+			{
+				// The allocated addresses are kept on update.
+				Config: `
+					resource "infoblox_ipv4_network" "net1" {
+						cidr = "17.0.0.0/24"
+					}
+					resource "infoblox_ipv6_network" "net2" {
+						cidr = "2001:db8:17::/64"
+					}
+					resource "infoblox_host_record" "host1" {
+						fqdn       = "host1.test.com"
+						enable_dns = false
+						comment    = "the IPv6 address is removed"
+
+						ipv4_address {
+							ipv4_addr   = "17.0.0.10"
+							mac         = "11:22:33:44:55:66"
+							enable_dhcp = true
+						}
+						ipv4_address {
+							cidr = infoblox_ipv4_network.net1.cidr
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_host_record.host1", "comment", "the IPv6 address is removed"),
+					resource.TestCheckResourceAttr("infoblox_host_record.host1", "ipv4_address.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_host_record.host1", "ipv4_address.0.options.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_host_record.host1", "ipv4_address.1.ipv4_addr", "17.0.0.1"),
+					resource.TestCheckResourceAttr("infoblox_host_record.host1", "ipv6_address.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,387 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func resourceHTTPSRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHTTPSRecordCreate,
+		ReadContext:   resourceHTTPSRecordRead,
+		UpdateContext: resourceHTTPSRecordUpdate,
+		DeleteContext: resourceHTTPSRecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHTTPSRecordImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The owner name of the HTTPS-record, in FQDN format.",
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+				Description:  "The priority (0..65535) of the HTTPS-record; 0 is for the AliasMode, any other value is for the ServiceMode.",
+			},
+			"target_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The target domain name of the HTTPS-record, in FQDN format.",
+			},
+			"svc_params": svcParamsSchema(),
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for the HTTPS-record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the HTTPS-record.",
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether the HTTPS-record is disabled or not.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone which the record belongs to.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the HTTPS-record to be added/updated, as a map in JSON format.",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+func setHTTPSRecordFields(d *schema.ResourceData, obj *ibclient.RecordHttps) error {
+	ttl := int(obj.Ttl)
+	if !obj.UseTtl {
+		ttl = ttlUndef
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+	if err := d.Set("dns_view", obj.View); err != nil {
+		return err
+	}
+	if err := d.Set("name", obj.Name); err != nil {
+		return err
+	}
+	if err := d.Set("priority", int(obj.Priority)); err != nil {
+		return err
+	}
+	if err := d.Set("target_name", obj.TargetName); err != nil {
+		return err
+	}
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("disable", obj.Disable); err != nil {
+		return err
+	}
+	if err := d.Set("zone", obj.Zone); err != nil {
+		return err
+	}
+
+	return d.Set("svc_params", convertSvcParamsToInterface(obj.SvcParameters))
+}
+
+func resourceHTTPSRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	dnsView := d.Get("dns_view").(string)
+	name := d.Get("name").(string)
+	priority := d.Get("priority").(int)
+	targetName := d.Get("target_name").(string)
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+
+	svcParams, err := svcParamsFromResource(priority, d.Get("svc_params").([]interface{}))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	ttl, useTtl, err := ttlFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+
+	var tenantID string
+	if tempVal, found := extAttrs[eaNameForTenantId]; found {
+		tenantID = tempVal.(string)
+	}
+	objMgr := ibclient.NewObjectManager(m.(ibclient.IBConnector), "Terraform", tenantID)
+
+	newRecord, err := objMgr.CreateHTTPSRecord(
+		name, uint32(priority), targetName, comment, "", "", false, disable, extAttrs, false, svcParams, ttl, useTtl, dnsView)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error creating HTTPS-record: %w", err))
+	}
+	d.SetId(newRecord.Ref)
+	if err = d.Set("ref", newRecord.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceHTTPSRecordRead(ctx, d, m)
+}
+
+func resourceHTTPSRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var obj ibclient.RecordHttps
+	if err = getObjectByRefOrInternalId(ibclient.NewEmptyHttpsRecord(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setHTTPSRecordFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceHTTPSRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevName, _ := d.GetChange("name")
+			prevPriority, _ := d.GetChange("priority")
+			prevTargetName, _ := d.GetChange("target_name")
+			prevSvcParams, _ := d.GetChange("svc_params")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevDisable, _ := d.GetChange("disable")
+			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("priority", prevPriority.(int))
+			_ = d.Set("target_name", prevTargetName.(string))
+			_ = d.Set("svc_params", prevSvcParams)
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("disable", prevDisable.(bool))
+			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+	if d.HasChange("dns_view") {
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
+	}
+
+	name := d.Get("name").(string)
+	priority := d.Get("priority").(int)
+	targetName := d.Get("target_name").(string)
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+
+	svcParams, err := svcParamsFromResource(priority, d.Get("svc_params").([]interface{}))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	ttl, useTtl, err := ttlFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var found ibclient.RecordHttps
+	if err = getObjectByRefOrInternalId(ibclient.NewEmptyHttpsRecord(), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	newExtAttrs, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var tenantID string
+	if tempVal, found := newExtAttrs[eaNameForTenantId]; found {
+		tenantID = tempVal.(string)
+	}
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
+
+	rec, err := objMgr.UpdateHTTPSRecord(
+		found.Ref, name, uint32(priority), targetName, comment, "", found.DdnsPrincipal, found.DdnsProtected, disable,
+		newExtAttrs, found.ForbidReclamation, svcParams, ttl, useTtl)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error updating HTTPS-record: %w", err))
+	}
+	updateSuccessful = true
+	d.SetId(rec.Ref)
+	if err = d.Set("ref", rec.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceHTTPSRecordRead(ctx, d, m)
+}
+
+func resourceHTTPSRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.RecordHttps
+	if err := getObjectByRefOrInternalId(ibclient.NewEmptyHttpsRecord(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	objMgr := ibclient.NewObjectManager(m.(ibclient.IBConnector), "Terraform", "")
+	if _, err := objMgr.DeleteHTTPSRecord(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("deletion of HTTPS-record failed: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceHTTPSRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	objMgr := ibclient.NewObjectManager(m.(ibclient.IBConnector), "Terraform", "")
+	obj, err := objMgr.GetHTTPSRecordByRef(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("failed getting HTTPS-record: %w", err)
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setHTTPSRecordFields(d, obj); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceHTTPSRecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,93 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckHTTPSRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_https_record" {
+			continue
+		}
+		objMgr := ibclient.NewObjectManager(meta.(ibclient.IBConnector), "terraform_test", "test")
+		if _, err := objMgr.GetHTTPSRecordByRef(rs.Primary.ID); err == nil {
+			return fmt.Errorf("HTTPS-record %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceHTTPSRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHTTPSRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "https.test.com"
+					}
+					resource "infoblox_https_record" "rec1" {
+						name        = "www.https.test.com"
+						priority    = 1
+						target_name = "."
+						svc_params {
+							alpn     = ["h2", "h3"]
+							ipv6hint = ["2001:db8:0:0::1"]
+							ech      = "AEX+DQBBpQAgACD4bSvyxxEkZl0gZU+O1HLJLHkJt0d8xdIffxNCZlC8OQAEAAEAAQASY2xvdWRmbGFyZS1lY2guY29tAAA="
+						}
+						comment = "web front end"
+						depends_on = [infoblox_zone_auth.zone1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_https_record.rec1", "zone", "https.test.com"),
+					resource.TestCheckResourceAttr("infoblox_https_record.rec1", "svc_params.0.alpn.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_https_record.rec1", "svc_params.0.alpn.1", "h3"),
+					resource.TestCheckResourceAttr("infoblox_https_record.rec1", "svc_params.0.ipv6hint.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_https_record.rec1", "svc_params.0.mandatory.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_https_record.rec1", "comment", "web front end"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "https.test.com"
+					}
+					resource "infoblox_https_record" "rec1" {
+						name        = "www.https.test.com"
+						priority    = 2
+						target_name = "cdn.https.test.com"
+						svc_params {
+							alpn      = ["h2"]
+							port      = 8443
+							mandatory = ["port", "alpn"]
+						}
+						disable    = true
+						depends_on = [infoblox_zone_auth.zone1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_https_record.rec1", "priority", "2"),
+					resource.TestCheckResourceAttr("infoblox_https_record.rec1", "svc_params.0.port", "8443"),
+					resource.TestCheckResourceAttr("infoblox_https_record.rec1", "svc_params.0.ipv6hint.#", "0"),
+					resource.TestCheckTypeSetElemAttr("infoblox_https_record.rec1", "svc_params.0.mandatory.*", "port"),
+					resource.TestCheckTypeSetElemAttr("infoblox_https_record.rec1", "svc_params.0.mandatory.*", "alpn"),
+					resource.TestCheckResourceAttr("infoblox_https_record.rec1", "disable", "true"),
+				),
+			},
+			{
+				ResourceName:            "infoblox_https_record.rec1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
@@ -1,12 +1,14 @@
 package infoblox
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	log "github.com/sirupsen/logrus"
 
@@ -35,16 +37,16 @@ func normalizeAndSortAliases(aliases []interface{}, domain string, enableDNS boo
 }
 
 func resourceIPAllocation() *schema.Resource {
-	// TODO: move towards context-aware equivalents of these fields, as these are deprecated.
 	return &schema.Resource{
-		Create: resourceAllocationRequest,
-		Read:   resourceAllocationGet,
-		Update: resourceAllocationUpdate,
-		Delete: resourceAllocationRelease,
+		CreateContext: resourceAllocationRequest,
+		ReadContext:   resourceAllocationGet,
+		UpdateContext: resourceAllocationUpdate,
+		DeleteContext: resourceAllocationRelease,
 
 		Importer: &schema.ResourceImporter{
-			State: ipAllocationImporter,
+			StateContext: ipAllocationImporter,
 		},
+		Timeouts: defaultTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			"network_view": {
@@ -157,6 +159,18 @@ func resourceIPAllocation() *schema.Resource {
 				Default:     "",
 				Description: "The extensible attributes for IP address allocation, as a map in JSON format",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"inherit_ext_attrs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Inherit the extensible attributes of the network, the IP address is allocated from, and keep them inherited instead of converting them to local values on update.",
+			},
+			"inherited_ext_attrs": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The extensible attributes inherited from the parent object, as a map in JSON format. Set when 'inherit_ext_attrs' is true.",
+			},
 			"disable": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -242,13 +256,13 @@ func getOrFindHostRec(d *schema.ResourceData, m interface{}) (
 	return objMgr.SearchHostRecordByAltId(actualIntId.String(), ref, eaNameForInternalId)
 }
 
-func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
+func resourceAllocationRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	networkView := d.Get("network_view").(string)
 	dnsView := d.Get("dns_view").(string)
 	enableDns := d.Get("enable_dns").(bool)
 	fqdn := d.Get("fqdn").(string)
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	ipv4Cidr := d.Get("ipv4_cidr").(string)
@@ -259,13 +273,13 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 	ipAdressType := d.Get("ip_address_type").(string)
 	if nextAvailableFilter == "" {
 		if err := d.Set("ip_address_type", ""); err != nil {
-			return err
+			return diagFromErr(err)
 
 		}
 	}
 	if (ipv4Cidr == "" && ipv6Cidr == "" && ipv4Addr == "" && ipv6Addr == "") && nextAvailableFilter == "" {
-		return fmt.Errorf("allocation through host address record creation needs an IPv4/IPv6 address" +
-			" or IPv4/IPv6 cidr or filter_params")
+		return diagFromErr(fmt.Errorf("allocation through host address record creation needs an IPv4/IPv6 address" +
+			" or IPv4/IPv6 cidr or filter_params"))
 	}
 
 	ZeroMacAddr := "00:00:00:00:00:00"
@@ -287,18 +301,19 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 	disable := d.Get("disable").(bool)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return fmt.Errorf("failed to allocate IP: %w", err)
+		return diagFromErr(fmt.Errorf("failed to allocate IP: %w", err))
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	var tenantID string
 	// TODO: where will we get this value from? What is its source?
 	if tempVal, ok := extAttrs[eaNameForTenantId]; ok {
@@ -320,9 +335,9 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 		err = json.Unmarshal([]byte(nextAvailableFilter), &eaMap)
 		eaMap["network_view"] = networkView
 		if err != nil {
-			return fmt.Errorf("error unmarshalling extra attributes of network: %s", err)
+			return diagFromErr(fmt.Errorf("error unmarshalling extra attributes of network: %w", err))
 		}
-		newRecordHost, err = objMgr.AllocateNextAvailableIp(fqdn, "record:host", eaMap, nil, false, extAttrs,
+		newRecordHost, err = objMgr.AllocateNextAvailableIp(fqdn, "record:host", eaMap, nil, d.Get("inherit_ext_attrs").(bool), extAttrs,
 			comment, disable, nil, ipAdressType, enableDns, false, "", "", networkView, dnsView, useTtl, ttl, aliasStrs)
 		d.Set("ip_address_type", ipAdressType)
 	} else {
@@ -334,27 +349,27 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error while creating a host record: %s", err.Error())
+		return diagFromErr(fmt.Errorf("error while creating a host record: %w", err))
 	}
 	hostRec := newRecordHost.(*ibclient.HostRecord)
 
 	d.SetId(internalId.String())
 	if err = d.Set("ref", hostRec.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// For compatibility reason. This field should be deprecated in the future.
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if hostRec.Ipv6Addrs == nil || len(hostRec.Ipv6Addrs) < 1 {
 		if err := d.Set("allocated_ipv6_addr", ""); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err := d.Set("allocated_ipv6_addr", hostRec.Ipv6Addrs[0].Ipv6Addr); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -365,22 +380,22 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if err = d.Set("aliases", aliasesInterface); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if hostRec.Ipv4Addrs == nil || len(hostRec.Ipv4Addrs) < 1 {
 		if err := d.Set("allocated_ipv4_addr", ""); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err := d.Set("allocated_ipv4_addr", hostRec.Ipv4Addrs[0].Ipv4Addr); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceAllocationGet(d, m)
+	return resourceAllocationGet(ctx, d, m)
 }
 
-func resourceAllocationGet(d *schema.ResourceData, m interface{}) error {
+func resourceAllocationGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
 	obj, err := getOrFindHostRec(d, m)
 	if err != nil {
@@ -389,37 +404,37 @@ func resourceAllocationGet(d *schema.ResourceData, m interface{}) error {
 			return nil
 		}
 
-		return err
+		return diagFromErr(err)
 	}
 
 	_, nextAvailableFilterOk := d.GetOk("filter_params")
 	if obj.Ipv6Addrs == nil || len(obj.Ipv6Addrs) < 1 {
 		if err := d.Set("allocated_ipv6_addr", ""); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err := d.Set("allocated_ipv6_addr", obj.Ipv6Addrs[0].Ipv6Addr); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		_, found := d.GetOk("ipv6_cidr")
 		if !found && !nextAvailableFilterOk {
 			if err := d.Set("ipv6_addr", obj.Ipv6Addrs[0].Ipv6Addr); err != nil {
-				return err
+				return diagFromErr(err)
 			}
 		}
 	}
 	if obj.Ipv4Addrs == nil || len(obj.Ipv4Addrs) < 1 {
 		if err := d.Set("allocated_ipv4_addr", ""); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err := d.Set("allocated_ipv4_addr", obj.Ipv4Addrs[0].Ipv4Addr); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		_, found := d.GetOk("ipv4_cidr")
 		if !found && !nextAvailableFilterOk {
 			if err := d.Set("ipv4_addr", obj.Ipv4Addrs[0].Ipv4Addr); err != nil {
-				return err
+				return diagFromErr(err)
 			}
 		}
 	}
@@ -431,51 +446,54 @@ func resourceAllocationGet(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if err = d.Set("aliases", aliasesInterface); err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	delete(obj.Ea, eaNameForInternalId)
 
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
+	if err = readInheritedEAs(d, m.(ibclient.IBConnector), obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("dns_view", obj.View); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("network_view", obj.NetworkView); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("enable_dns", obj.EnableDns); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("fqdn", obj.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("disable", obj.Disable); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if obj.Ttl != nil {
@@ -485,17 +503,17 @@ func resourceAllocationGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error) {
+func resourceAllocationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -517,6 +535,7 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 			prevComment, _ := d.GetChange("comment")
 			prevDisable, _ := d.GetChange("disable")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("network_view", prevNetView.(string))
 			_ = d.Set("dns_view", prevDNSView.(string))
@@ -533,6 +552,7 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("disable", prevDisable.(bool))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 		}
 	}()
 
@@ -540,26 +560,26 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); ok {
 			d.SetId("")
-			return ibclient.NewNotFoundError(fmt.Sprintf(
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
 				"cannot find apropriate object on NIOS side for resource with ID '%s': %s;"+
 					" removing the resource from Terraform state",
-				d.Id(), err))
+				d.Id(), err)))
 		}
 
-		return err
+		return diagFromErr(err)
 	}
 
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'network_view' field is not allowed"))
 	}
 	if d.HasChange("filter_params") {
-		return fmt.Errorf("changing the value of 'filter_params' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'filter_params' field is not allowed"))
 	}
 	if d.HasChange("ip_address_type") {
-		return fmt.Errorf("changing the value of 'ip_address_type' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'ip_address_type' field is not allowed"))
 	}
 
 	enableDNS := d.Get("enable_dns").(bool)
@@ -572,15 +592,15 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 		aliasStrs[i] = alias.(string)
 	}
 	if d.HasChange("dns_view") && !d.HasChange("enable_dns") {
-		return fmt.Errorf(
-			"changing the value of 'dns_view' field is allowed only for the case of changing 'enable_dns' option")
+		return diagFromErr(fmt.Errorf(
+			"changing the value of 'dns_view' field is allowed only for the case of changing 'enable_dns' option"))
 	}
 	if enableDNS {
 		if dnsView == disabledDNSView {
-			return fmt.Errorf("a valid DNS view's name MUST be defined ('dns_view' property) once 'enable_dns' has been changed from 'false' to 'true'")
+			return diagFromErr(fmt.Errorf("a valid DNS view's name MUST be defined ('dns_view' property) once 'enable_dns' has been changed from 'false' to 'true'"))
 		}
 		if !strings.ContainsRune(fqdn, '.') {
-			return fmt.Errorf("'fqdn' value must be an FQDN without a trailing dot")
+			return diagFromErr(fmt.Errorf("'fqdn' value must be an FQDN without a trailing dot"))
 		}
 
 	}
@@ -613,22 +633,15 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 	disable := d.Get("disable").(bool)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -694,12 +707,12 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 
 	hr, err := objMgr.GetHostRecordByRef(hostRecObj.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to update IP allocation: %w", err)
+		return diagFromErr(fmt.Errorf("failed to update IP allocation: %w", err))
 	}
 
 	mergedEAs, err := mergeEAs(hr.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	hostRecObj, err = objMgr.UpdateHostRecord(
@@ -717,27 +730,30 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 		mergedEAs,
 		aliasStrs, disable)
 	if err != nil {
-		return fmt.Errorf(
-			"error while updating the host record with ID '%s': %s", d.Id(), err.Error())
+		return diagFromErr(fmt.Errorf(
+			"error while updating the host record with ID '%s': %w", d.Id(), err))
+	}
+	if err = updateEAInheritance(d, m, hostRecObj.Ref, mergedEAs, false); err != nil {
+		return diagFromErr(err)
 	}
 	updateSuccessful = true
 	if err = d.Set("ref", hostRecObj.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("dns_view", hostRecObj.View); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("fqdn", hostRecObj.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if hostRecObj.Ipv6Addrs == nil || len(hostRecObj.Ipv6Addrs) < 1 {
 		if err := d.Set("allocated_ipv6_addr", ""); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err := d.Set("allocated_ipv6_addr", hostRecObj.Ipv6Addrs[0].Ipv6Addr); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	alias := hostRecObj.Aliases
@@ -747,33 +763,32 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 	}
 
 	if err = d.Set("aliases", aliasesInterface); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if hostRecObj.Ipv4Addrs == nil || len(hostRecObj.Ipv4Addrs) < 1 {
 		if err := d.Set("allocated_ipv4_addr", ""); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err := d.Set("allocated_ipv4_addr", hostRecObj.Ipv4Addrs[0].Ipv4Addr); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceAllocationRelease(d *schema.ResourceData, m interface{}) error {
+func resourceAllocationRelease(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'network_view' field is not allowed"))
 	}
 	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
 	}
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return fmt.Errorf("failed to delete network container: %w", err)
+		return diagFromErr(fmt.Errorf("failed to delete network container: %w", err))
 	}
 
 	var tenantID string
@@ -784,7 +799,7 @@ func resourceAllocationRelease(d *schema.ResourceData, m interface{}) error {
 	hostRec, err := getOrFindHostRec(d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return fmt.Errorf("cannot retrieve existing record from NIOS server for the resource ID %q: %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf("cannot retrieve existing record from NIOS server for the resource ID %q: %w", d.Id(), err))
 		}
 
 		// The resource seems to be deleted already,
@@ -802,14 +817,14 @@ func resourceAllocationRelease(d *schema.ResourceData, m interface{}) error {
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 	_, err = objMgr.DeleteHostRecord(hostRec.Ref)
 	if err != nil {
-		return fmt.Errorf("error while releasing the resource with ID '%s': %s", d.Id(), err.Error())
+		return diagFromErr(fmt.Errorf("error while releasing the resource with ID '%s': %w", d.Id(), err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func ipAllocationImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ipAllocationImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
 	internalId := newInternalResourceIdFromString(d.Id())
 	if internalId == nil {
@@ -854,21 +869,27 @@ func ipAllocationImporter(d *schema.ResourceData, m interface{}) ([]*schema.Reso
 		}
 	}
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	_, err = terraformDeserializeEAs(extAttrJSON)
+	_, err = terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
 
 	delete(obj.Ea, eaNameForInternalId)
 
+	// Inherited EAs are not managed by the resource.
+	inheritedEAs, err := getInheritedEAs(m.(ibclient.IBConnector), obj.Ref)
+	if err != nil {
+		return nil, err
+	}
+	obj.Ea = withoutInheritedEAs(obj.Ea, inheritedEAs)
+
 	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
 		if err != nil {
 			return nil, err
 		}
 
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -1,9 +1,11 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	log "github.com/sirupsen/logrus"
 
@@ -13,8 +15,9 @@ import (
 func resourceIpAssociation() *schema.Resource {
 	return &schema.Resource{
 		Importer: &schema.ResourceImporter{
-			State: ipAssociationImporter,
+			StateContext: ipAssociationImporter,
 		},
+		Timeouts: defaultTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			"mac_addr": {
@@ -48,16 +51,16 @@ func resourceIpAssociation() *schema.Resource {
 }
 
 // TODO: add validation of values (extra spaces, format, etc)
-func resourceIpAssociationUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceIpAssociationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if d.HasChange("internal_id") {
 		restoreIpAssociationState(d)
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 
-	return resourceIpAssociationCreateUpdate(d, m)
+	return resourceIpAssociationCreateUpdate(ctx, d, m)
 }
 
-func resourceIpAssociationRead(d *schema.ResourceData, m interface{}) error {
+func resourceIpAssociationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var (
 		err                       error
 		hostRec                   *ibclient.HostRecord
@@ -74,12 +77,12 @@ func resourceIpAssociationRead(d *schema.ResourceData, m interface{}) error {
 			return nil
 		}
 
-		return err
+		return diagFromErr(err)
 	}
 
 	if hostRec.Ipv6Addrs != nil && len(hostRec.Ipv6Addrs) > 0 {
 		if len(hostRec.Ipv6Addrs) > 1 {
-			return fmt.Errorf("association with multiple IP addresses are not supported")
+			return diagFromErr(fmt.Errorf("association with multiple IP addresses are not supported"))
 		}
 
 		enableDhcpActualIpv6 = *hostRec.Ipv6Addrs[0].EnableDhcp
@@ -92,7 +95,7 @@ func resourceIpAssociationRead(d *schema.ResourceData, m interface{}) error {
 
 	if hostRec.Ipv4Addrs != nil && len(hostRec.Ipv4Addrs) > 0 {
 		if len(hostRec.Ipv4Addrs) > 1 {
-			return fmt.Errorf("association with multiple IP addresses are not supported")
+			return diagFromErr(fmt.Errorf("association with multiple IP addresses are not supported"))
 		}
 
 		enableDhcpActualIpv4 = *hostRec.Ipv4Addrs[0].EnableDhcp
@@ -106,26 +109,26 @@ func resourceIpAssociationRead(d *schema.ResourceData, m interface{}) error {
 	enableDhcpActual = enableDhcpActualIpv4 || enableDhcpActualIpv6
 
 	if err = d.Set("ref", hostRec.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("duid", duidActual); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("mac_addr", macAddrActual); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("enable_dhcp", enableDhcpActual); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func resourceIpAssociationDelete(d *schema.ResourceData, m interface{}) error {
+func resourceIpAssociationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// TODO: process carefully the case: the host record is already deleted
 	if err := resourceIpAssociationCreateUpdateCommon(d, m, "00:00:00:00:00:00", ""); err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return fmt.Errorf("error getting the allocated host record with ID '%s': %s", d.Id(), err.Error())
+			return diagFromErr(fmt.Errorf("error getting the allocated host record with ID '%s': %w", d.Id(), err))
 		}
 
 		log.Warnf(
@@ -138,7 +141,7 @@ func resourceIpAssociationDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceIpAssociationCreateUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceIpAssociationCreateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var mac, duid string
 
 	val, ok := d.GetOk("mac_addr")
@@ -150,7 +153,7 @@ func resourceIpAssociationCreateUpdate(d *schema.ResourceData, m interface{}) er
 		duid = val.(string)
 	}
 
-	return resourceIpAssociationCreateUpdateCommon(d, m, mac, duid)
+	return diagFromErr(resourceIpAssociationCreateUpdateCommon(d, m, mac, duid))
 }
 
 func restoreIpAssociationState(d *schema.ResourceData) {
@@ -264,8 +267,8 @@ func resourceIpAssociationCreateUpdateCommon(
 		hostRec.Ea, alias, disable)
 	if err != nil {
 		return fmt.Errorf(
-			"failed to update the resource with ID '%s' (host record with internal ID '%s'): %s",
-			d.Id(), internalIdStr, err.Error())
+			"failed to update the resource with ID '%s' (host record with internal ID '%s'): %w",
+			d.Id(), internalIdStr, err)
 	}
 	updateSuccessful = true
 
@@ -283,15 +286,15 @@ func resourceIpAssociationCreateUpdateCommon(
 
 func resourceIpAssociationInit() *schema.Resource {
 	association := resourceIpAssociation()
-	association.Create = resourceIpAssociationCreateUpdate
-	association.Read = resourceIpAssociationRead
-	association.Update = resourceIpAssociationUpdate
-	association.Delete = resourceIpAssociationDelete
+	association.CreateContext = resourceIpAssociationCreateUpdate
+	association.ReadContext = resourceIpAssociationRead
+	association.UpdateContext = resourceIpAssociationUpdate
+	association.DeleteContext = resourceIpAssociationDelete
 
 	return association
 }
 
-func ipAssociationImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ipAssociationImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	internalId := newInternalResourceIdFromString(d.Id())
 	if internalId == nil {
 		return nil, fmt.Errorf("ID value provided is not in a proper format")
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"reflect"
@@ -11,13 +12,14 @@ import (
 
 func resourceRange() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceRangeCreate,
-		Read:   resourceRangeRead,
-		Update: resourceRangeUpdate,
-		Delete: resourceRangeDelete,
+		CreateContext: resourceRangeCreate,
+		ReadContext:   resourceRangeRead,
+		UpdateContext: resourceRangeUpdate,
+		DeleteContext: resourceRangeDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceRangeImport,
+			StateContext: resourceRangeImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -74,6 +76,18 @@ func resourceRange() *schema.Resource {
 				Optional:    true,
 				Description: "Extensible attributes of the range to be added/updated, as a map in JSON format.",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"inherit_ext_attrs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Keep the extensible attributes, inherited from the parent network, inherited, instead of converting them to local values on update.",
+			},
+			"inherited_ext_attrs": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The extensible attributes inherited from the parent object, as a map in JSON format. Set when 'inherit_ext_attrs' is true.",
+			},
 			"failover_association": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -219,10 +233,10 @@ func resourceRange() *schema.Resource {
 	}
 }
 
-func resourceRangeCreate(d *schema.ResourceData, m interface{}) error {
+func resourceRangeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	comment := d.Get("comment").(string)
 	name := d.Get("name").(string)
@@ -234,12 +248,11 @@ func resourceRangeCreate(d *schema.ResourceData, m interface{}) error {
 	startAddr := d.Get("start_addr").(string)
 	endAddr := d.Get("end_addr").(string)
 	disable := d.Get("disable").(bool)
-	extAttrJSON := d.Get("ext_attrs").(string)
 	useOptions := d.Get("use_options").(bool)
 	optionsInterface := d.Get("options").([]interface{})
 	options, err := validateDhcpOptions(optionsInterface)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	serverAssociationType := d.Get("server_association_type").(string)
 	failOverAssociation := d.Get("failover_association").(string)
@@ -248,13 +261,15 @@ func resourceRangeCreate(d *schema.ResourceData, m interface{}) error {
 	member := d.Get("member").(map[string]interface{})
 	dhcpMember, err := ConvertMapToDhcpMember(member)
 	if err != nil {
-		return fmt.Errorf("failed to convert member to dhcpmember: %w", err)
+		return diagFromErr(fmt.Errorf("failed to convert member to dhcpmember: %w", err))
 	}
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	// Generate internal ID and add it to the extensible attributes
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -268,30 +283,29 @@ func resourceRangeCreate(d *schema.ResourceData, m interface{}) error {
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 	newNetworkRange, err := objMgr.CreateNetworkRange(comment, name, network, networkView, startAddr, endAddr, disable, extAttrs, dhcpMember, failOverAssociation, options, useOptions, serverAssociationType, template, msServer)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(newNetworkRange.Ref)
 	if err = d.Set("ref", newNetworkRange.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	return resourceRangeRead(d, m)
+	return resourceRangeRead(ctx, d, m)
 
 }
-func resourceRangeRead(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceRangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rec, err := searchObjectByRefOrInternalId("Range", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -300,81 +314,85 @@ func resourceRangeRead(d *schema.ResourceData, m interface{}) error {
 	var networkRange *ibclient.Range
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal network range: %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal network range: %w", err))
 	}
 	err = json.Unmarshal(recJson, &networkRange)
 	if err != nil {
-		return fmt.Errorf("failed getting network range : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting network range : %w", err))
 	}
 
 	delete(networkRange.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(networkRange.Ea, extAttrs)
+	if err = readInheritedEAs(d, m.(ibclient.IBConnector), networkRange.Ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	omittedEAs := omitEAs(networkRange.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 	// Assertion of object type and error handling
 	if err = d.Set("comment", networkRange.Comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("name", networkRange.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("network", networkRange.Network); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("network_view", networkRange.NetworkView); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("start_addr", networkRange.StartAddr); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("end_addr", networkRange.EndAddr); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("disable", networkRange.Disable); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("failover_association", networkRange.FailoverAssociation); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if networkRange.MsServer != nil {
 		if err = d.Set("ms_server", networkRange.MsServer.Ipv4Addr); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err = d.Set("ms_server", ""); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if networkRange.Member != nil {
 		member := convertDhcpMemberToMap(networkRange.Member)
 		if err = d.Set("member", member); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if err = d.Set("server_association_type", networkRange.ServerAssociationType); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("options", convertDhcpOptionsToInterface(networkRange.Options)); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("use_options", networkRange.UseOptions); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("template", networkRange.Template); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(networkRange.Ref)
 	return nil
 }
-func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceRangeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -388,6 +406,7 @@ func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
 			prevStartAddr, _ := d.GetChange("start_addr")
 			prevEndAddr, _ := d.GetChange("end_addr")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 			prevMember, _ := d.GetChange("member")
 			prevDisable, _ := d.GetChange("disable")
 			prevOptions, _ := d.GetChange("options")
@@ -405,6 +424,7 @@ func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("end_addr", prevEndAddr.(string))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 			_ = d.Set("network_view", prevNetworkView.(string))
 			_ = d.Set("member", prevMember)
 			_ = d.Set("disable", prevDisable.(bool))
@@ -417,10 +437,10 @@ func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
 		}
 	}()
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'network_view' field is not allowed"))
 	}
 	comment := d.Get("comment").(string)
 	name := d.Get("name").(string)
@@ -433,24 +453,17 @@ func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
 	member := d.Get("member").(map[string]interface{})
 	dhcpMember, err := ConvertMapToDhcpMember(member)
 	if err != nil {
-		return fmt.Errorf("failed to convert member to dhcpmember: %w", err)
+		return diagFromErr(fmt.Errorf("failed to convert member to dhcpmember: %w", err))
 	}
 	networkView := d.Get("network_view").(string)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	failoverAssociation := d.Get("failover_association").(string)
 	serverAssociationType := d.Get("server_association_type").(string)
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -466,8 +479,8 @@ func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("Range", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -475,11 +488,11 @@ func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal Network Range : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal Network Range : %w", err))
 	}
 	err = json.Unmarshal(recJson, &networkRange)
 	if err != nil {
-		return fmt.Errorf("failed getting Network Range : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting Network Range : %w", err))
 	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
@@ -498,37 +511,39 @@ func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
 	newList, okNew := newOptions.([]interface{})
 
 	if !okOld || !okNew {
-		return fmt.Errorf("options is not a slice of interfaces")
+		return diagFromErr(fmt.Errorf("options is not a slice of interfaces"))
 	}
 
 	optimizedOptions := optimizeDhcpOptions(oldList, newList)
 	options, err := validateDhcpOptions(optimizedOptions)
 	if err != nil {
-		return fmt.Errorf("failed to validate options: %w", err)
+		return diagFromErr(fmt.Errorf("failed to validate options: %w", err))
 	}
 
 	networkRange, err = objMgr.UpdateNetworkRange(d.Id(), comment, name, network, startAddr, endAddr, disable, newExtAttrs, dhcpMember, failoverAssociation, options, useOptions, serverAssociationType, networkView, msServer)
 	if err != nil {
-		return fmt.Errorf("Failed to update network range with %s, ", err.Error())
+		return diagFromErr(fmt.Errorf("Failed to update network range with %w, ", err))
+	}
+	if err = updateEAInheritance(d, m, networkRange.Ref, newExtAttrs, false); err != nil {
+		return diagFromErr(err)
 	}
 
 	updateSuccessful = true
 
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", networkRange.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(networkRange.Ref)
-	return resourceRangeRead(d, m)
+	return resourceRangeRead(ctx, d, m)
 
 }
-func resourceRangeDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceRangeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -543,8 +558,8 @@ func resourceRangeDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("Range", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -554,23 +569,22 @@ func resourceRangeDelete(d *schema.ResourceData, m interface{}) error {
 	var networkRange *ibclient.Range
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal network range : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal network range : %w", err))
 	}
 	err = json.Unmarshal(recJson, &networkRange)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	_, err = objMgr.DeleteNetworkRange(networkRange.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to delete network range : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to delete network range : %w", err))
 	}
 
 	return nil
 
 }
-func resourceRangeImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	_, err := terraformDeserializeEAs(extAttrJSON)
+func resourceRangeImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	_, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -583,12 +597,19 @@ func resourceRangeImport(d *schema.ResourceData, m interface{}) ([]*schema.Resou
 		return nil, fmt.Errorf("failed getting network range : %w", err)
 	}
 
+	// Inherited EAs are not managed by the resource.
+	inheritedEAs, err := getInheritedEAs(m.(ibclient.IBConnector), networkRange.Ref)
+	if err != nil {
+		return nil, err
+	}
+	networkRange.Ea = withoutInheritedEAs(networkRange.Ea, inheritedEAs)
+
 	if networkRange.Ea != nil && len(networkRange.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(networkRange.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(networkRange.Ea, m))
 		if err != nil {
 			return nil, err
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -669,9 +690,8 @@ func resourceRangeImport(d *schema.ResourceData, m interface{}) ([]*schema.Resou
 	d.SetId(networkRange.Ref)
 
 	// Update the resource with the EA Terraform Internal ID
-	err = resourceRangeUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceRangeUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"reflect"
@@ -14,13 +15,14 @@ import (
 
 func resourceIpv4SharedNetwork() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIpv4SharedNetworkCreate,
-		Read:   resourceIpv4SharedNetworkRead,
-		Update: resourceIpv4SharedNetworkUpdate,
-		Delete: resourceIpv4SharedNetworkDelete,
+		CreateContext: resourceIpv4SharedNetworkCreate,
+		ReadContext:   resourceIpv4SharedNetworkRead,
+		UpdateContext: resourceIpv4SharedNetworkUpdate,
+		DeleteContext: resourceIpv4SharedNetworkDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceIpv4SharedNetworkImport,
+			StateContext: resourceIpv4SharedNetworkImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -47,6 +49,7 @@ func resourceIpv4SharedNetwork() *schema.Resource {
 				Default:     "",
 				Description: "Extensible attributes of the IPv4 Shared Network record to be added/updated, as a map in JSON format.",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 			"disable": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -235,10 +238,10 @@ func extractCIDR(network string) string {
 	return network
 }
 
-func resourceIpv4SharedNetworkCreate(d *schema.ResourceData, m interface{}) error {
+func resourceIpv4SharedNetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	name := d.Get("name").(string)
 	comment := d.Get("comment").(string)
@@ -255,14 +258,15 @@ func resourceIpv4SharedNetworkCreate(d *schema.ResourceData, m interface{}) erro
 	options := d.Get("options").([]interface{})
 	optionsList, err := validateDhcpOptions(options)
 	if err != nil {
-		return fmt.Errorf("failed to validate options: %w", err)
+		return diagFromErr(fmt.Errorf("failed to validate options: %w", err))
 	}
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
+
+	extAttrs = withProviderEAs(extAttrs, m)
 	// Generate internal ID and add it to the extensible attributes
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -278,25 +282,24 @@ func resourceIpv4SharedNetworkCreate(d *schema.ResourceData, m interface{}) erro
 	// create a sharedNetwork object
 	sharedNetwork, err := objMgr.CreateIpv4SharedNetwork(name, networksList, networkView, extAttrs, comment, disable, useOptions, optionsList)
 	if err != nil {
-		return fmt.Errorf("failed to create a sharedNetwork object: %s", err)
+		return diagFromErr(fmt.Errorf("failed to create a sharedNetwork object: %w", err))
 	}
 	d.SetId(sharedNetwork.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", sharedNetwork.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIpv4SharedNetworkRead(d, m)
+	return resourceIpv4SharedNetworkRead(ctx, d, m)
 }
 
-func resourceIpv4SharedNetworkRead(d *schema.ResourceData, m interface{}) error {
+func resourceIpv4SharedNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rec, err := searchObjectByRefOrInternalId("SharedNetwork", d, m)
@@ -305,71 +308,71 @@ func resourceIpv4SharedNetworkRead(d *schema.ResourceData, m interface{}) error
 			d.SetId("")
 			return nil
 		} else {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		}
 	}
 
 	var sharedNetwork *ibclient.SharedNetwork
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal shared network record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal shared network record : %w", err))
 	}
 	err = json.Unmarshal(recJson, &sharedNetwork)
 	if err != nil {
-		return fmt.Errorf("failed getting shared network record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting shared network record : %w", err))
 	}
 
 	delete(sharedNetwork.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(sharedNetwork.Ea, extAttrs)
+	omittedEAs := omitEAs(sharedNetwork.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 	if sharedNetwork.Name != nil {
 		if err = d.Set("name", *sharedNetwork.Name); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if sharedNetwork.Comment != nil {
 		if err = d.Set("comment", *sharedNetwork.Comment); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if sharedNetwork.Disable != nil {
 		if err = d.Set("disable", *sharedNetwork.Disable); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if sharedNetwork.Networks != nil {
 		networks := setNetworksRef(sharedNetwork.Networks)
 		if err = d.Set("networks", networks); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if err = d.Set("network_view", sharedNetwork.NetworkView); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if sharedNetwork.UseOptions != nil {
 		if err = d.Set("use_options", *sharedNetwork.UseOptions); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	if sharedNetwork.Options != nil {
 		networksInterface := convertDhcpOptionsToInterface(sharedNetwork.Options)
 		if err = d.Set("options", networksInterface); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("ref", sharedNetwork.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(sharedNetwork.Ref)
 	return nil
@@ -386,7 +389,7 @@ func setNetworksRef(networks []*ibclient.Ipv4Network) interface{} {
 	return ipv4Networks
 }
 
-func resourceIpv4SharedNetworkUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceIpv4SharedNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		if !updateSuccessful {
@@ -398,6 +401,7 @@ func resourceIpv4SharedNetworkUpdate(d *schema.ResourceData, m interface{}) erro
 			prevUseOptions, _ := d.GetChange("use_options")
 			prevOptions, _ := d.GetChange("options")
 			prevExtAttrs, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("name", prevName)
 			_ = d.Set("comment", prevComment)
@@ -407,22 +411,17 @@ func resourceIpv4SharedNetworkUpdate(d *schema.ResourceData, m interface{}) erro
 			_ = d.Set("use_options", prevUseOptions)
 			_ = d.Set("options", prevOptions)
 			_ = d.Set("ext_attrs", prevExtAttrs)
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 		}
 	}()
 
 	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
-	}
-
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(fmt.Errorf("changing the value of 'network_view' field is not allowed"))
 	}
 
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -449,8 +448,8 @@ func resourceIpv4SharedNetworkUpdate(d *schema.ResourceData, m interface{}) erro
 	rec, err := searchObjectByRefOrInternalId("SharedNetwork", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -458,11 +457,11 @@ func resourceIpv4SharedNetworkUpdate(d *schema.ResourceData, m interface{}) erro
 	}
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal sharedNetwork record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal sharedNetwork record : %w", err))
 	}
 	err = json.Unmarshal(recJson, &sharedNetwork)
 	if err != nil {
-		return fmt.Errorf("failed getting sharedNetwork record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting sharedNetwork record : %w", err))
 	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
@@ -475,7 +474,7 @@ func resourceIpv4SharedNetworkUpdate(d *schema.ResourceData, m interface{}) erro
 
 	newExtAttrs, err = mergeEAs(sharedNetwork.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// Check if the options field has changes
@@ -484,37 +483,36 @@ func resourceIpv4SharedNetworkUpdate(d *schema.ResourceData, m interface{}) erro
 	newList, okNew := newOptions.([]interface{})
 
 	if !okOld || !okNew {
-		return fmt.Errorf("options is not a slice of interfaces")
+		return diagFromErr(fmt.Errorf("options is not a slice of interfaces"))
 	}
 
 	optimizedOptions := optimizeDhcpOptions(oldList, newList)
 	options, err := validateDhcpOptions(optimizedOptions)
 	if err != nil {
-		return fmt.Errorf("failed to validate options: %w", err)
+		return diagFromErr(fmt.Errorf("failed to validate options: %w", err))
 	}
 
 	sharedNetwork, err = objMgr.UpdateIpv4SharedNetwork(d.Id(), name, networksList, networkView, comment, newExtAttrs, disable, useOptions, options)
 	if err != nil {
-		return fmt.Errorf("failed to update sharedNetwork: %s.", err.Error())
+		return diagFromErr(fmt.Errorf("failed to update sharedNetwork: %w.", err))
 	}
 
 	updateSuccessful = true
 
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", sharedNetwork.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(sharedNetwork.Ref)
-	return resourceIpv4SharedNetworkRead(d, m)
+	return resourceIpv4SharedNetworkRead(ctx, d, m)
 }
 
-func resourceIpv4SharedNetworkDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceIpv4SharedNetworkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -529,8 +527,8 @@ func resourceIpv4SharedNetworkDelete(d *schema.ResourceData, m interface{}) erro
 	rec, err := searchObjectByRefOrInternalId("SharedNetwork", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -540,23 +538,22 @@ func resourceIpv4SharedNetworkDelete(d *schema.ResourceData, m interface{}) erro
 	var sharedNetwork *ibclient.SharedNetwork
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal shared network record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal shared network record : %w", err))
 	}
 	err = json.Unmarshal(recJson, &sharedNetwork)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	_, err = objMgr.DeleteIpv4SharedNetwork(sharedNetwork.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to delete shared network : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to delete shared network : %w", err))
 	}
 
 	return nil
 }
 
-func resourceIpv4SharedNetworkImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	_, err := terraformDeserializeEAs(extAttrJSON)
+func resourceIpv4SharedNetworkImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	_, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -570,11 +567,11 @@ func resourceIpv4SharedNetworkImport(d *schema.ResourceData, m interface{}) ([]*
 	}
 
 	if sharedNetwork.Ea != nil && len(sharedNetwork.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(sharedNetwork.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(sharedNetwork.Ea, m))
 		if err != nil {
 			return nil, err
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -623,9 +620,8 @@ func resourceIpv4SharedNetworkImport(d *schema.ResourceData, m interface{}) ([]*
 	d.SetId(sharedNetwork.Ref)
 
 	// Update the resource with the EA Terraform Internal ID
-	err = resourceIpv4SharedNetworkUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceIpv4SharedNetworkUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
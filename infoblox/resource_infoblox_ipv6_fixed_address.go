@@ -0,0 +1,511 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var ipv6FixedAddressReturnFields = []string{
+	"address_type", "comment", "disable", "duid", "extattrs", "ipv6addr", "ipv6prefix", "ipv6prefix_bits",
+	"name", "network", "network_view", "options", "use_options",
+}
+
+func newEmptyIpv6FixedAddress() *ibclient.Ipv6FixedAddress {
+	obj := &ibclient.Ipv6FixedAddress{}
+	obj.SetReturnFields(ipv6FixedAddressReturnFields)
+
+	return obj
+}
+
+func normalizeOptionalIPAddress(val interface{}) string {
+	if val == "" {
+		return ""
+	}
+	return normalizeIPAddress(val)
+}
+
+func resourceIpv6FixedAddress() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpv6FixedAddressCreate,
+		ReadContext:   resourceIpv6FixedAddressRead,
+		UpdateContext: resourceIpv6FixedAddressUpdate,
+		DeleteContext: resourceIpv6FixedAddressDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIpv6FixedAddressImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"address_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ADDRESS",
+				ValidateFunc: validation.StringInSlice([]string{"ADDRESS", "PREFIX", "BOTH"}, false),
+				Description:  "The type of the reservation: an IPv6 address (ADDRESS), an IPv6 prefix (PREFIX) or both of them (BOTH).",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment for the IPv6 fixed address; maximum 256 characters.",
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether the IPv6 fixed address is disabled or not.",
+			},
+			"duid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The DHCPv6 Unique Identifier (DUID) of the client, the address is reserved for.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the IPv6 fixed address, as a map in JSON format",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"ipv6addr": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The IPv6 address of the fixed address. If it is not set for the ADDRESS or BOTH address type, " +
+					"the next available address of 'network' is allocated.",
+				StateFunc: normalizeOptionalIPAddress,
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					oldNetwork, _ := d.GetChange("network")
+					if oldValue != "" && newValue == "" && oldNetwork != "" {
+						return true
+					}
+					return false
+				},
+			},
+			"ipv6prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The IPv6 prefix, reserved for the PREFIX or BOTH address type.",
+				StateFunc:   normalizeOptionalIPAddress,
+			},
+			"ipv6prefix_bits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+				Description:  "The length of the IPv6 prefix, reserved for the PREFIX or BOTH address type.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the IPv6 fixed address.",
+			},
+			"network": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The IPv6 network, in CIDR format, the fixed address belongs to and allocates the next available address from.",
+				StateFunc:   normalizeOptionalIPAddress,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if d.Get("ipv6addr").(string) != "" && new == "" {
+						return true
+					}
+					return false
+				},
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "The name of the network view in which the IPv6 fixed address resides.",
+			},
+			"options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "An array of DHCPv6 option structs that lists the DHCP options associated with the object. " +
+					"When defining a DHCP option, at least a ‘name’ or a ‘num’ is required.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the DHCP option.",
+						},
+						"num": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The code of the DHCP option.",
+						},
+						"use_option": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+							Description: "Only applies to special options that are displayed separately from other options and have a use flag, " +
+								"such as `dhcp6.name-servers`.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Value of the DHCP option.",
+						},
+						"vendor_class": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "DHCPv6",
+							Description: "The name of the space this DHCP option is associated to.",
+						},
+					},
+				},
+			},
+			"use_options": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Use option is a flag that indicates whether the options field are used or not.",
+			},
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+// ipv6FixedAddressFromResource makes an IPv6 fixed address of the resource's fields, except for the extensible attributes.
+// ipv6Addr is the address to reserve, empty to allocate the next available address of the network.
+func ipv6FixedAddressFromResource(d *schema.ResourceData, ipv6Addr string) (*ibclient.Ipv6FixedAddress, error) {
+	addressType := d.Get("address_type").(string)
+	network := d.Get("network").(string)
+	networkView := d.Get("network_view").(string)
+	ipv6Prefix := d.Get("ipv6prefix").(string)
+	ipv6PrefixBits := uint32(d.Get("ipv6prefix_bits").(int))
+
+	obj := &ibclient.Ipv6FixedAddress{
+		AddressType: addressType,
+		NetworkView: &networkView,
+	}
+	if addressType != "PREFIX" {
+		if ipv6Addr == "" {
+			if network == "" {
+				return nil, fmt.Errorf("either 'ipv6addr' or 'network' field is required for the '%s' address type", addressType)
+			}
+			ipv6Addr = fmt.Sprintf("func:nextavailableip:%s,%s", network, networkView)
+		}
+		obj.Ipv6Addr = &ipv6Addr
+	}
+	if addressType != "ADDRESS" {
+		if ipv6Prefix == "" || ipv6PrefixBits == 0 {
+			return nil, fmt.Errorf("'ipv6prefix' and 'ipv6prefix_bits' fields are required for the '%s' address type", addressType)
+		}
+		obj.Ipv6prefix = &ipv6Prefix
+		obj.Ipv6prefixBits = &ipv6PrefixBits
+	}
+
+	duid := d.Get("duid").(string)
+	obj.Duid = &duid
+	name := d.Get("name").(string)
+	obj.Name = &name
+	comment := d.Get("comment").(string)
+	obj.Comment = &comment
+	disable := d.Get("disable").(bool)
+	obj.Disable = &disable
+	useOptions := d.Get("use_options").(bool)
+	obj.UseOptions = &useOptions
+
+	options, err := validateDhcpOptions(d.Get("options").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	obj.Options = options
+
+	return obj, nil
+}
+
+func setIpv6FixedAddressFields(d *schema.ResourceData, obj *ibclient.Ipv6FixedAddress) error {
+	if err := d.Set("address_type", obj.AddressType); err != nil {
+		return err
+	}
+	if err := d.Set("comment", stringPtrValue(obj.Comment)); err != nil {
+		return err
+	}
+	if err := d.Set("disable", obj.Disable != nil && *obj.Disable); err != nil {
+		return err
+	}
+	if err := d.Set("duid", stringPtrValue(obj.Duid)); err != nil {
+		return err
+	}
+	if err := d.Set("ipv6addr", stringPtrValue(obj.Ipv6Addr)); err != nil {
+		return err
+	}
+	if err := d.Set("ipv6prefix", stringPtrValue(obj.Ipv6prefix)); err != nil {
+		return err
+	}
+	var ipv6PrefixBits int
+	if obj.Ipv6prefixBits != nil {
+		ipv6PrefixBits = int(*obj.Ipv6prefixBits)
+	}
+	if err := d.Set("ipv6prefix_bits", ipv6PrefixBits); err != nil {
+		return err
+	}
+	if err := d.Set("name", stringPtrValue(obj.Name)); err != nil {
+		return err
+	}
+	if err := d.Set("network", stringPtrValue(obj.Network)); err != nil {
+		return err
+	}
+	if err := d.Set("network_view", stringPtrValue(obj.NetworkView)); err != nil {
+		return err
+	}
+	if err := d.Set("use_options", obj.UseOptions != nil && *obj.UseOptions); err != nil {
+		return err
+	}
+
+	return d.Set("options", convertDhcpOptionsToInterface(obj.Options))
+}
+
+func resourceIpv6FixedAddressCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Check if internal_id is set manually
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	obj, err := ipv6FixedAddressFromResource(d, d.Get("ipv6addr").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	obj.Ea = extAttrs
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(obj)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("creation of IPv6 fixed address failed: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceIpv6FixedAddressRead(ctx, d, m)
+}
+
+func resourceIpv6FixedAddressRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var obj ibclient.Ipv6FixedAddress
+	if err = getObjectByRefOrInternalId(newEmptyIpv6FixedAddress(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setIpv6FixedAddressFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceIpv6FixedAddressUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		if !updateSuccessful {
+			// Reverting the state back, in case of a failure,
+			// otherwise Terraform will keep the values, which leaded to the failure,
+			// in the state file.
+			prevAddressType, _ := d.GetChange("address_type")
+			prevComment, _ := d.GetChange("comment")
+			prevDisable, _ := d.GetChange("disable")
+			prevDuid, _ := d.GetChange("duid")
+			prevIpv6addr, _ := d.GetChange("ipv6addr")
+			prevIpv6prefix, _ := d.GetChange("ipv6prefix")
+			prevIpv6prefixBits, _ := d.GetChange("ipv6prefix_bits")
+			prevName, _ := d.GetChange("name")
+			prevNetwork, _ := d.GetChange("network")
+			prevNetworkView, _ := d.GetChange("network_view")
+			prevUseOptions, _ := d.GetChange("use_options")
+			prevOptions, _ := d.GetChange("options")
+			prevExtAttrsJSON, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("address_type", prevAddressType.(string))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("disable", prevDisable.(bool))
+			_ = d.Set("duid", prevDuid.(string))
+			_ = d.Set("ipv6addr", prevIpv6addr.(string))
+			_ = d.Set("ipv6prefix", prevIpv6prefix.(string))
+			_ = d.Set("ipv6prefix_bits", prevIpv6prefixBits.(int))
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("network", prevNetwork.(string))
+			_ = d.Set("network_view", prevNetworkView.(string))
+			_ = d.Set("use_options", prevUseOptions.(bool))
+			_ = d.Set("options", prevOptions)
+			_ = d.Set("ext_attrs", prevExtAttrsJSON.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+	if d.HasChange("network_view") {
+		return diagFromErr(fmt.Errorf("changing the value of 'network_view' field is not allowed"))
+	}
+
+	// A change of the network allocates the next available address of the new one.
+	ipv6Addr := d.Get("ipv6addr").(string)
+	if d.HasChange("network") && !d.HasChange("ipv6addr") {
+		ipv6Addr = ""
+	}
+	obj, err := ipv6FixedAddressFromResource(d, ipv6Addr)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	// The network view is not updatable.
+	obj.NetworkView = nil
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var found ibclient.Ipv6FixedAddress
+	if err = getObjectByRefOrInternalId(newEmptyIpv6FixedAddress(), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	obj.Ea, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := connector.UpdateObject(obj, found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error updating IPv6 fixed address: %w", err))
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceIpv6FixedAddressRead(ctx, d, m)
+}
+
+func resourceIpv6FixedAddressDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.Ipv6FixedAddress
+	if err := getObjectByRefOrInternalId(newEmptyIpv6FixedAddress(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err := m.(ibclient.IBConnector).DeleteObject(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("deletion of IPv6 fixed address failed: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIpv6FixedAddressImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	var obj ibclient.Ipv6FixedAddress
+	err := m.(ibclient.IBConnector).GetObject(newEmptyIpv6FixedAddress(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting IPv6 fixed address: %w", err)
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setIpv6FixedAddressFields(d, &obj); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceIpv6FixedAddressUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
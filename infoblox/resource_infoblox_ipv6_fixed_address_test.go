@@ -0,0 +1,162 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// testSearchConnector serves the object by its reference and the search results by the internal ID.
+type testSearchConnector struct {
+	ibclient.IBConnector
+
+	byRef    map[string]string
+	searched string
+}
+
+func (c *testSearchConnector) GetObject(obj ibclient.IBObject, ref string, qp *ibclient.QueryParams, res interface{}) error {
+	if ref == "" {
+		return json.Unmarshal([]byte(c.searched), res)
+	}
+	rec, found := c.byRef[ref]
+	if !found {
+		return ibclient.NewNotFoundError("not found")
+	}
+	return json.Unmarshal([]byte(rec), res)
+}
+
+func TestGetObjectByRefOrInternalId(t *testing.T) {
+	const (
+		internalId = "6c3d4f2e-3f0a-4c1e-9d2a-7b1f0c2d3e4f"
+		oldRef     = "ipv6fixedaddress/ZG5zLmZpeGVkX2FkZHJlc3MkMjAwMTpkYjg6OjEw:2001%3Adb8%3A%3A10/default"
+		newRef     = "ipv6fixedaddress/ZG5zLmZpeGVkX2FkZHJlc3MkMjAwMTpkYjg6OjIw:2001%3Adb8%3A%3A20/default"
+	)
+	conn := &testSearchConnector{
+		byRef: map[string]string{
+			oldRef: `{"_ref": "` + oldRef + `", "ipv6addr": "2001:db8::10", "extattrs": {}}`,
+		},
+		searched: `[{"_ref": "` + newRef + `", "ipv6addr": "2001:db8::20", "extattrs": {"Terraform Internal ID": {"value": "` + internalId + `"}}}]`,
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceIpv6FixedAddress().Schema, map[string]interface{}{})
+	d.SetId(oldRef)
+	if err := d.Set("ref", oldRef); err != nil {
+		t.Fatal(err)
+	}
+
+	// Without the internal ID, the object is found by its reference.
+	var obj ibclient.Ipv6FixedAddress
+	if err := getObjectByRefOrInternalId(newEmptyIpv6FixedAddress(), d, conn, &obj); err != nil {
+		t.Fatal(err)
+	}
+	if obj.Ref != oldRef {
+		t.Fatalf("unexpected object: %s", obj.Ref)
+	}
+
+	// The object, the reference points to, has another internal ID: the object is searched by it.
+	if err := d.Set("internal_id", internalId); err != nil {
+		t.Fatal(err)
+	}
+	obj = ibclient.Ipv6FixedAddress{}
+	if err := getObjectByRefOrInternalId(newEmptyIpv6FixedAddress(), d, conn, &obj); err != nil {
+		t.Fatal(err)
+	}
+	if obj.Ref != newRef || stringPtrValue(obj.Ipv6Addr) != "2001:db8::20" {
+		t.Fatalf("unexpected object: %s", obj.Ref)
+	}
+
+	conn.searched = "[]"
+	if err := getObjectByRefOrInternalId(newEmptyIpv6FixedAddress(), d, conn, &obj); !isNotFoundError(err) {
+		t.Fatalf("expected a 'not found' error, got %v", err)
+	}
+}
+
+func testAccCheckIpv6FixedAddressDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_ipv6_fixed_address" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.Ipv6FixedAddress
+		err := connector.GetObject(newEmptyIpv6FixedAddress(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("IPv6 fixed address %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceIpv6FixedAddress(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpv6FixedAddressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv6_network" "net1" {
+						cidr = "2001:db8:15::/64"
+					}
+					resource "infoblox_ipv6_fixed_address" "fix1" {
+						network = infoblox_ipv6_network.net1.cidr
+						duid    = "00:01:00:01:2a:3b:4c:5d:11:22:33:44:55:66"
+						name    = "fixed-address-1"
+						comment = "next available address"
+						options {
+							name         = "domain-name"
+							num          = 24
+							value        = "example.com"
+							vendor_class = "DHCPv6"
+						}
+						use_options = true
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.fix1", "address_type", "ADDRESS"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.fix1", "ipv6addr", "2001:db8:15::1"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.fix1", "network", "2001:db8:15::/64"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.fix1", "options.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.fix1", "options.0.value", "example.com"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.fix1", "ext_attrs", `{"Site":"HQ"}`),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv6_network" "net1" {
+						cidr = "2001:db8:15::/64"
+					}
+					resource "infoblox_ipv6_fixed_address" "fix1" {
+						address_type    = "BOTH"
+						ipv6addr        = "2001:db8:15::10"
+						ipv6prefix      = "2001:db8:1500::"
+						ipv6prefix_bits = 56
+						duid            = "00:01:00:01:2a:3b:4c:5d:11:22:33:44:55:66"
+						name            = "fixed-address-1"
+						depends_on      = [infoblox_ipv6_network.net1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.fix1", "address_type", "BOTH"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.fix1", "ipv6addr", "2001:db8:15::10"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.fix1", "ipv6prefix", "2001:db8:1500::"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.fix1", "ipv6prefix_bits", "56"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.fix1", "options.#", "0"),
+				),
+			},
+			{
+				ResourceName:            "infoblox_ipv6_fixed_address.fix1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
@@ -0,0 +1,641 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var ipv6RangeReturnFields = []string{
+	"address_type", "comment", "disable", "end_addr", "exclude", "extattrs", "ipv6_end_prefix", "ipv6_prefix_bits",
+	"ipv6_start_prefix", "member", "name", "network", "network_view", "option_filter_rules",
+	"server_association_type", "start_addr",
+}
+
+// ipv6RangeObject is the generated IPv6Range, which sends empty lists of exclusions and option filters,
+// instead of omitting them, so that they may be removed on update.
+type ipv6RangeObject struct {
+	ibclient.IPv6Range
+	Exclude           []*ibclient.Exclusionrange `json:"exclude"`
+	OptionFilterRules []*ibclient.Filterrule     `json:"option_filter_rules"`
+}
+
+func newEmptyIpv6Range() *ibclient.IPv6Range {
+	obj := &ibclient.IPv6Range{}
+	obj.SetReturnFields(ipv6RangeReturnFields)
+
+	return obj
+}
+
+// optionFilterRulesSchema describes the DHCP filters, applied to a range, by their names.
+func optionFilterRulesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Description: "The option filters applied to the range: the appliance uses the matching rules of these " +
+			"filters to select the address range, from which it assigns a lease.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"filter": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the DHCP filter.",
+				},
+				"permission": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, false),
+					Description:  "The permission to be applied: 'Allow' or 'Deny'.",
+				},
+			},
+		},
+	}
+}
+
+func convertFilterRulesToInterface(rules []*ibclient.Filterrule) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		res = append(res, map[string]interface{}{
+			"filter":     rule.Filter,
+			"permission": rule.Permission,
+		})
+	}
+
+	return res
+}
+
+func convertInterfaceToFilterRules(rules []interface{}) []*ibclient.Filterrule {
+	res := make([]*ibclient.Filterrule, 0, len(rules))
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		res = append(res, &ibclient.Filterrule{
+			Filter:     rule["filter"].(string),
+			Permission: rule["permission"].(string),
+		})
+	}
+
+	return res
+}
+
+func convertExclusionRangesToInterface(exclusions []*ibclient.Exclusionrange) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(exclusions))
+	for _, e := range exclusions {
+		res = append(res, map[string]interface{}{
+			"start_address": e.StartAddress,
+			"end_address":   e.EndAddress,
+			"comment":       e.Comment,
+		})
+	}
+
+	return res
+}
+
+func convertInterfaceToExclusionRanges(exclusions []interface{}) []*ibclient.Exclusionrange {
+	res := make([]*ibclient.Exclusionrange, 0, len(exclusions))
+	for _, e := range exclusions {
+		exclusion := e.(map[string]interface{})
+		res = append(res, &ibclient.Exclusionrange{
+			StartAddress: exclusion["start_address"].(string),
+			EndAddress:   exclusion["end_address"].(string),
+			Comment:      exclusion["comment"].(string),
+		})
+	}
+
+	return res
+}
+
+func resourceIpv6Range() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpv6RangeCreate,
+		ReadContext:   resourceIpv6RangeRead,
+		UpdateContext: resourceIpv6RangeUpdate,
+		DeleteContext: resourceIpv6RangeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIpv6RangeImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"address_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ADDRESS",
+				ValidateFunc: validation.StringInSlice([]string{"ADDRESS", "PREFIX", "BOTH"}, false),
+				Description: "The type of the range: 'ADDRESS' for a range of IPv6 addresses, 'PREFIX' for a range of " +
+					"IPv6 prefixes for prefix delegation, or 'BOTH'.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment for the range; maximum 256 characters.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the range.",
+			},
+			"network": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The IPv6 network to which this range belongs, in CIDR format.",
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "The name of the network view in which this range resides.",
+			},
+			"start_addr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   normalizeOptionalIPAddress,
+				Description: "The IPv6 starting address of the range. Required for the 'ADDRESS' and 'BOTH' address types.",
+			},
+			"end_addr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   normalizeOptionalIPAddress,
+				Description: "The IPv6 end address of the range. Required for the 'ADDRESS' and 'BOTH' address types.",
+			},
+			"ipv6_start_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   normalizeOptionalIPAddress,
+				Description: "The starting IPv6 prefix of the range. Required for the 'PREFIX' and 'BOTH' address types.",
+			},
+			"ipv6_end_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   normalizeOptionalIPAddress,
+				Description: "The end IPv6 prefix of the range. Required for the 'PREFIX' and 'BOTH' address types.",
+			},
+			"ipv6_prefix_bits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+				Description:  "The length of the delegated prefixes. Required for the 'PREFIX' and 'BOTH' address types.",
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "The ranges of IPv6 addresses, which the appliance does not assign to clients; " +
+					"they may be used as static addresses.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							StateFunc:   normalizeIPAddress,
+							Description: "The IPv6 starting address of the exclusion range.",
+						},
+						"end_address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							StateFunc:   normalizeIPAddress,
+							Description: "The IPv6 end address of the exclusion range.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Comment for the exclusion range; maximum 256 characters.",
+						},
+					},
+				},
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether a range is disabled or not. When this is set to False, the range is enabled.",
+			},
+			"member": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The member that will provide service for this range. server_association_type needs to be set to 'MEMBER' if you want " +
+					"the server specified here to serve the range.",
+			},
+			"server_association_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice([]string{"MEMBER", "NONE"}, false),
+				Description:  "The type of server that is going to serve the range. The valid values are: 'MEMBER', 'NONE'.",
+			},
+			"option_filter_rules": optionFilterRulesSchema(),
+			"template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If set on creation, the range will be created according to the values specified in the named template.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the range to be added/updated, as a map in JSON format.",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"inherit_ext_attrs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Keep the extensible attributes, inherited from the parent network, inherited, instead of converting them to local values on update.",
+			},
+			"inherited_ext_attrs": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The extensible attributes inherited from the parent object, as a map in JSON format. Set when 'inherit_ext_attrs' is true.",
+			},
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+func ipv6RangeFromResource(d *schema.ResourceData) (*ipv6RangeObject, error) {
+	addressType := d.Get("address_type").(string)
+	networkView := d.Get("network_view").(string)
+	if networkView == "" {
+		networkView = defaultNetView
+	}
+
+	obj := &ipv6RangeObject{
+		IPv6Range: ibclient.IPv6Range{
+			AddressType: &addressType,
+			NetworkView: &networkView,
+		},
+	}
+	if addressType != "PREFIX" {
+		startAddr := d.Get("start_addr").(string)
+		endAddr := d.Get("end_addr").(string)
+		if startAddr == "" || endAddr == "" {
+			return nil, fmt.Errorf("'start_addr' and 'end_addr' fields are required for the '%s' address type", addressType)
+		}
+		obj.StartAddr = &startAddr
+		obj.EndAddr = &endAddr
+	}
+	if addressType != "ADDRESS" {
+		startPrefix := d.Get("ipv6_start_prefix").(string)
+		endPrefix := d.Get("ipv6_end_prefix").(string)
+		prefixBits := uint32(d.Get("ipv6_prefix_bits").(int))
+		if startPrefix == "" || endPrefix == "" || prefixBits == 0 {
+			return nil, fmt.Errorf(
+				"'ipv6_start_prefix', 'ipv6_end_prefix' and 'ipv6_prefix_bits' fields are required for the '%s' address type",
+				addressType)
+		}
+		obj.Ipv6StartPrefix = &startPrefix
+		obj.Ipv6EndPrefix = &endPrefix
+		obj.Ipv6PrefixBits = &prefixBits
+	}
+	if network := d.Get("network").(string); network != "" {
+		obj.Network = &network
+	}
+
+	comment := d.Get("comment").(string)
+	obj.Comment = &comment
+	name := d.Get("name").(string)
+	obj.Name = &name
+	disable := d.Get("disable").(bool)
+	obj.Disable = &disable
+	serverAssociationType := d.Get("server_association_type").(string)
+	obj.ServerAssociationType = &serverAssociationType
+
+	member, err := ConvertMapToDhcpMember(d.Get("member").(map[string]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert member to dhcpmember: %w", err)
+	}
+	obj.Member = member
+	obj.Exclude = convertInterfaceToExclusionRanges(d.Get("exclude").([]interface{}))
+	obj.OptionFilterRules = convertInterfaceToFilterRules(d.Get("option_filter_rules").([]interface{}))
+
+	return obj, nil
+}
+
+func setIpv6RangeFields(d *schema.ResourceData, obj *ibclient.IPv6Range) error {
+	if err := d.Set("address_type", stringPtrValue(obj.AddressType)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", stringPtrValue(obj.Comment)); err != nil {
+		return err
+	}
+	if err := d.Set("name", stringPtrValue(obj.Name)); err != nil {
+		return err
+	}
+	if err := d.Set("network", stringPtrValue(obj.Network)); err != nil {
+		return err
+	}
+	if err := d.Set("network_view", stringPtrValue(obj.NetworkView)); err != nil {
+		return err
+	}
+	if err := d.Set("start_addr", stringPtrValue(obj.StartAddr)); err != nil {
+		return err
+	}
+	if err := d.Set("end_addr", stringPtrValue(obj.EndAddr)); err != nil {
+		return err
+	}
+	if err := d.Set("ipv6_start_prefix", stringPtrValue(obj.Ipv6StartPrefix)); err != nil {
+		return err
+	}
+	if err := d.Set("ipv6_end_prefix", stringPtrValue(obj.Ipv6EndPrefix)); err != nil {
+		return err
+	}
+	var prefixBits int
+	if obj.Ipv6PrefixBits != nil {
+		prefixBits = int(*obj.Ipv6PrefixBits)
+	}
+	if err := d.Set("ipv6_prefix_bits", prefixBits); err != nil {
+		return err
+	}
+	if err := d.Set("disable", obj.Disable != nil && *obj.Disable); err != nil {
+		return err
+	}
+	if err := d.Set("member", convertDhcpMemberToMap(obj.Member)); err != nil {
+		return err
+	}
+	if err := d.Set("server_association_type", stringPtrValue(obj.ServerAssociationType)); err != nil {
+		return err
+	}
+	if err := d.Set("exclude", convertExclusionRangesToInterface(obj.Exclude)); err != nil {
+		return err
+	}
+
+	return d.Set("option_filter_rules", convertFilterRulesToInterface(obj.OptionFilterRules))
+}
+
+func resourceIpv6RangeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Check if internal_id is set manually
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	obj, err := ipv6RangeFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	obj.Template = d.Get("template").(string)
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	obj.Ea = extAttrs
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(obj)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("creation of IPv6 range failed: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceIpv6RangeRead(ctx, d, m)
+}
+
+func resourceIpv6RangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var obj ibclient.IPv6Range
+	if err = getObjectByRefOrInternalId(newEmptyIpv6Range(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if err = readInheritedEAs(d, m.(ibclient.IBConnector), obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setIpv6RangeFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceIpv6RangeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevAddressType, _ := d.GetChange("address_type")
+			prevComment, _ := d.GetChange("comment")
+			prevName, _ := d.GetChange("name")
+			prevNetwork, _ := d.GetChange("network")
+			prevNetworkView, _ := d.GetChange("network_view")
+			prevStartAddr, _ := d.GetChange("start_addr")
+			prevEndAddr, _ := d.GetChange("end_addr")
+			prevStartPrefix, _ := d.GetChange("ipv6_start_prefix")
+			prevEndPrefix, _ := d.GetChange("ipv6_end_prefix")
+			prevPrefixBits, _ := d.GetChange("ipv6_prefix_bits")
+			prevExclude, _ := d.GetChange("exclude")
+			prevDisable, _ := d.GetChange("disable")
+			prevMember, _ := d.GetChange("member")
+			prevServerAssociationType, _ := d.GetChange("server_association_type")
+			prevOptionFilterRules, _ := d.GetChange("option_filter_rules")
+			prevTemplate, _ := d.GetChange("template")
+			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			// TODO: move to the new Terraform plugin framework and
+			// process all the errors instead of ignoring them here.
+			_ = d.Set("address_type", prevAddressType.(string))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("network", prevNetwork.(string))
+			_ = d.Set("network_view", prevNetworkView.(string))
+			_ = d.Set("start_addr", prevStartAddr.(string))
+			_ = d.Set("end_addr", prevEndAddr.(string))
+			_ = d.Set("ipv6_start_prefix", prevStartPrefix.(string))
+			_ = d.Set("ipv6_end_prefix", prevEndPrefix.(string))
+			_ = d.Set("ipv6_prefix_bits", prevPrefixBits.(int))
+			_ = d.Set("exclude", prevExclude)
+			_ = d.Set("disable", prevDisable.(bool))
+			_ = d.Set("member", prevMember)
+			_ = d.Set("server_association_type", prevServerAssociationType.(string))
+			_ = d.Set("option_filter_rules", prevOptionFilterRules)
+			_ = d.Set("template", prevTemplate.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+	if d.HasChange("network_view") {
+		return diagFromErr(fmt.Errorf("changing the value of 'network_view' field is not allowed"))
+	}
+
+	obj, err := ipv6RangeFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	// The network view is not updatable.
+	obj.NetworkView = nil
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var found ibclient.IPv6Range
+	if err = getObjectByRefOrInternalId(newEmptyIpv6Range(), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	obj.Ea, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := connector.UpdateObject(obj, found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error updating IPv6 range: %w", err))
+	}
+	if err = updateEAInheritance(d, m, ref, obj.Ea, false); err != nil {
+		return diagFromErr(err)
+	}
+	updateSuccessful = true
+
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceIpv6RangeRead(ctx, d, m)
+}
+
+func resourceIpv6RangeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.IPv6Range
+	if err := getObjectByRefOrInternalId(newEmptyIpv6Range(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err := m.(ibclient.IBConnector).DeleteObject(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("deletion of IPv6 range failed: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIpv6RangeImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	conn := m.(ibclient.IBConnector)
+
+	var obj ibclient.IPv6Range
+	err := conn.GetObject(newEmptyIpv6Range(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting IPv6 range: %w", err)
+	}
+
+	// Inherited EAs are not managed by the resource.
+	inheritedEAs, err := getInheritedEAs(conn, obj.Ref)
+	if err != nil {
+		return nil, err
+	}
+	obj.Ea = withoutInheritedEAs(obj.Ea, inheritedEAs)
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setIpv6RangeFields(d, &obj); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceIpv6RangeUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,332 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var ipv6RangeTemplateReturnFields = []string{
+	"cloud_api_compatible", "comment", "exclude", "member", "name", "number_of_addresses", "offset",
+	"option_filter_rules", "server_association_type",
+}
+
+// ipv6RangeTemplateObject is the generated Ipv6rangetemplate, which sends empty lists of exclusions and
+// option filters, instead of omitting them, so that they may be removed on update.
+type ipv6RangeTemplateObject struct {
+	ibclient.Ipv6rangetemplate
+	Exclude           []*ibclient.Exclusionrangetemplate `json:"exclude"`
+	OptionFilterRules []*ibclient.Filterrule             `json:"option_filter_rules"`
+}
+
+func newEmptyIpv6RangeTemplate() *ibclient.Ipv6rangetemplate {
+	obj := &ibclient.Ipv6rangetemplate{}
+	obj.SetReturnFields(ipv6RangeTemplateReturnFields)
+
+	return obj
+}
+
+func convertExclusionRangeTemplatesToInterface(exclusions []*ibclient.Exclusionrangetemplate) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(exclusions))
+	for _, e := range exclusions {
+		res = append(res, map[string]interface{}{
+			"offset":              int(e.Offset),
+			"number_of_addresses": int(e.NumberOfAddresses),
+			"comment":             e.Comment,
+		})
+	}
+
+	return res
+}
+
+func convertInterfaceToExclusionRangeTemplates(exclusions []interface{}) []*ibclient.Exclusionrangetemplate {
+	res := make([]*ibclient.Exclusionrangetemplate, 0, len(exclusions))
+	for _, e := range exclusions {
+		exclusion := e.(map[string]interface{})
+		res = append(res, &ibclient.Exclusionrangetemplate{
+			Offset:            uint32(exclusion["offset"].(int)),
+			NumberOfAddresses: uint32(exclusion["number_of_addresses"].(int)),
+			Comment:           exclusion["comment"].(string),
+		})
+	}
+
+	return res
+}
+
+func resourceIpv6RangeTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpv6RangeTemplateCreate,
+		ReadContext:   resourceIpv6RangeTemplateRead,
+		UpdateContext: resourceIpv6RangeTemplateUpdate,
+		DeleteContext: resourceIpv6RangeTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIpv6RangeTemplateImport,
+		},
+		Timeouts: defaultTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the IPv6 range template.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment for the IPv6 range template; maximum 256 characters.",
+			},
+			"number_of_addresses": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of addresses of a range, created from the template.",
+			},
+			"offset": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The offset of the start address of a range, created from the template, in its network.",
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "The ranges of IPv6 addresses, which the appliance does not assign to clients, " +
+					"of a range created from the template.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"offset": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The address offset of the exclusion range.",
+						},
+						"number_of_addresses": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of addresses in the exclusion range.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Comment for the exclusion range; maximum 256 characters.",
+						},
+					},
+				},
+			},
+			"member": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The member that will provide service for a range, created from the template. server_association_type needs " +
+					"to be set to 'MEMBER' if you want the server specified here to serve the range.",
+			},
+			"server_association_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice([]string{"MEMBER", "NONE"}, false),
+				Description:  "The type of server that is going to serve a range, created from the template. The valid values are: 'MEMBER', 'NONE'.",
+			},
+			"option_filter_rules": optionFilterRulesSchema(),
+			"cloud_api_compatible": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "This flag controls whether this template can be used to create network objects in a cloud-computing deployment.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+func ipv6RangeTemplateFromResource(d *schema.ResourceData) (*ipv6RangeTemplateObject, error) {
+	name := d.Get("name").(string)
+	comment := d.Get("comment").(string)
+	numberOfAddresses := uint32(d.Get("number_of_addresses").(int))
+	offset := uint32(d.Get("offset").(int))
+	cloudApiCompatible := d.Get("cloud_api_compatible").(bool)
+
+	member, err := ConvertMapToDhcpMember(d.Get("member").(map[string]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert member to dhcpmember: %w", err)
+	}
+
+	return &ipv6RangeTemplateObject{
+		Ipv6rangetemplate: ibclient.Ipv6rangetemplate{
+			Name:                  &name,
+			Comment:               &comment,
+			NumberOfAddresses:     &numberOfAddresses,
+			Offset:                &offset,
+			CloudApiCompatible:    &cloudApiCompatible,
+			Member:                member,
+			ServerAssociationType: d.Get("server_association_type").(string),
+		},
+		Exclude:           convertInterfaceToExclusionRangeTemplates(d.Get("exclude").([]interface{})),
+		OptionFilterRules: convertInterfaceToFilterRules(d.Get("option_filter_rules").([]interface{})),
+	}, nil
+}
+
+func setIpv6RangeTemplateFields(d *schema.ResourceData, obj *ibclient.Ipv6rangetemplate) error {
+	if err := d.Set("name", stringPtrValue(obj.Name)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", stringPtrValue(obj.Comment)); err != nil {
+		return err
+	}
+	if obj.NumberOfAddresses != nil {
+		if err := d.Set("number_of_addresses", int(*obj.NumberOfAddresses)); err != nil {
+			return err
+		}
+	}
+	if obj.Offset != nil {
+		if err := d.Set("offset", int(*obj.Offset)); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("cloud_api_compatible", obj.CloudApiCompatible != nil && *obj.CloudApiCompatible); err != nil {
+		return err
+	}
+	if err := d.Set("member", convertDhcpMemberToMap(obj.Member)); err != nil {
+		return err
+	}
+	if err := d.Set("server_association_type", obj.ServerAssociationType); err != nil {
+		return err
+	}
+	if err := d.Set("exclude", convertExclusionRangeTemplatesToInterface(obj.Exclude)); err != nil {
+		return err
+	}
+
+	return d.Set("option_filter_rules", convertFilterRulesToInterface(obj.OptionFilterRules))
+}
+
+func resourceIpv6RangeTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	obj, err := ipv6RangeTemplateFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(obj)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("creation of IPv6 range template failed: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceIpv6RangeTemplateRead(ctx, d, m)
+}
+
+func resourceIpv6RangeTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The IPv6 range template has no extensible attributes, so it is identified only by its reference.
+	var obj ibclient.Ipv6rangetemplate
+	err := m.(ibclient.IBConnector).GetObject(newEmptyIpv6RangeTemplate(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if err = setIpv6RangeTemplateFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceIpv6RangeTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevName, _ := d.GetChange("name")
+			prevComment, _ := d.GetChange("comment")
+			prevNumberOfAddresses, _ := d.GetChange("number_of_addresses")
+			prevOffset, _ := d.GetChange("offset")
+			prevExclude, _ := d.GetChange("exclude")
+			prevMember, _ := d.GetChange("member")
+			prevServerAssociationType, _ := d.GetChange("server_association_type")
+			prevOptionFilterRules, _ := d.GetChange("option_filter_rules")
+			prevCloudApiCompatible, _ := d.GetChange("cloud_api_compatible")
+
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("number_of_addresses", prevNumberOfAddresses.(int))
+			_ = d.Set("offset", prevOffset.(int))
+			_ = d.Set("exclude", prevExclude)
+			_ = d.Set("member", prevMember)
+			_ = d.Set("server_association_type", prevServerAssociationType.(string))
+			_ = d.Set("option_filter_rules", prevOptionFilterRules)
+			_ = d.Set("cloud_api_compatible", prevCloudApiCompatible.(bool))
+		}
+	}()
+
+	obj, err := ipv6RangeTemplateFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := m.(ibclient.IBConnector).UpdateObject(obj, d.Id())
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error updating IPv6 range template: %w", err))
+	}
+	updateSuccessful = true
+
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceIpv6RangeTemplateRead(ctx, d, m)
+}
+
+func resourceIpv6RangeTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if _, err := m.(ibclient.IBConnector).DeleteObject(d.Id()); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); ok {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("deletion of IPv6 range template failed: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIpv6RangeTemplateImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	var obj ibclient.Ipv6rangetemplate
+	err := m.(ibclient.IBConnector).GetObject(newEmptyIpv6RangeTemplate(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting IPv6 range template: %w", err)
+	}
+
+	if err = setIpv6RangeTemplateFields(d, &obj); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,84 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckIpv6RangeTemplateDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_ipv6_range_template" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.Ipv6rangetemplate
+		err := connector.GetObject(newEmptyIpv6RangeTemplate(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("IPv6 range template %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceIpv6RangeTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpv6RangeTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv6_range_template" "template1" {
+						name                = "ipv6-range-template-1"
+						number_of_addresses = 100
+						offset              = 50
+						comment             = "DHCPv6 range template"
+						exclude {
+							offset              = 10
+							number_of_addresses = 5
+							comment             = "static addresses"
+						}
+						member = {
+							name = "infoblox.localdomain"
+						}
+						server_association_type = "MEMBER"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv6_range_template.template1", "number_of_addresses", "100"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range_template.template1", "offset", "50"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range_template.template1", "exclude.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range_template.template1", "exclude.0.number_of_addresses", "5"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range_template.template1", "member.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range_template.template1", "server_association_type", "MEMBER"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv6_range_template" "template1" {
+						name                 = "ipv6-range-template-1"
+						number_of_addresses  = 200
+						offset               = 20
+						cloud_api_compatible = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv6_range_template.template1", "number_of_addresses", "200"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range_template.template1", "offset", "20"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range_template.template1", "exclude.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range_template.template1", "cloud_api_compatible", "true"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range_template.template1", "server_association_type", "NONE"),
+				),
+			},
+			{
+				ResourceName:      "infoblox_ipv6_range_template.template1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,155 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func TestIpv6RangeFromResource(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceIpv6Range().Schema, map[string]interface{}{
+		"address_type":      "PREFIX",
+		"ipv6_start_prefix": "2001:db8:100::",
+		"ipv6_end_prefix":   "2001:db8:1ff::",
+		"ipv6_prefix_bits":  56,
+		"option_filter_rules": []interface{}{
+			map[string]interface{}{"filter": "voip", "permission": "Allow"},
+		},
+	})
+
+	obj, err := ipv6RangeFromResource(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj.ObjectType() != "ipv6range" {
+		t.Fatalf("unexpected object type: %s", obj.ObjectType())
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(data, &fields); err != nil {
+		t.Fatal(err)
+	}
+
+	// An empty list of exclusions is sent, so that the exclusions may be removed.
+	if string(fields["exclude"]) != "[]" {
+		t.Errorf("unexpected exclusions: %s", fields["exclude"])
+	}
+	if string(fields["option_filter_rules"]) != `[{"filter":"voip","permission":"Allow"}]` {
+		t.Errorf("unexpected option filter rules: %s", fields["option_filter_rules"])
+	}
+	if _, found := fields["start_addr"]; found {
+		t.Errorf("the start address is sent for a prefix range")
+	}
+	if string(fields["ipv6_prefix_bits"]) != "56" {
+		t.Errorf("unexpected prefix bits: %s", fields["ipv6_prefix_bits"])
+	}
+
+	if err = d.Set("ipv6_prefix_bits", 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ipv6RangeFromResource(d); err == nil {
+		t.Errorf("expected an error for a prefix range without the prefix length")
+	}
+}
+
+func testAccCheckIpv6RangeDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_ipv6_range" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.IPv6Range
+		err := connector.GetObject(newEmptyIpv6Range(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("IPv6 range %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceIpv6Range(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpv6RangeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv6_network" "net1" {
+						cidr = "2001:db8:17::/64"
+					}
+					resource "infoblox_ipv6_range" "range1" {
+						network    = infoblox_ipv6_network.net1.cidr
+						start_addr = "2001:db8:17::100"
+						end_addr   = "2001:db8:17::1ff"
+						name       = "range-1"
+						comment    = "DHCPv6 range"
+						exclude {
+							start_address = "2001:db8:17::110"
+							end_address   = "2001:db8:17::11f"
+							comment       = "static addresses"
+						}
+						member = {
+							name = "infoblox.localdomain"
+						}
+						server_association_type = "MEMBER"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "address_type", "ADDRESS"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "network", "2001:db8:17::/64"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "start_addr", "2001:db8:17::100"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "end_addr", "2001:db8:17::1ff"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "exclude.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "exclude.0.start_address", "2001:db8:17::110"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "member.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "server_association_type", "MEMBER"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "ext_attrs", `{"Site":"HQ"}`),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv6_network" "net1" {
+						cidr = "2001:db8:17::/64"
+					}
+					resource "infoblox_ipv6_range" "range1" {
+						address_type      = "BOTH"
+						network           = infoblox_ipv6_network.net1.cidr
+						start_addr        = "2001:db8:17::100"
+						end_addr          = "2001:db8:17::2ff"
+						ipv6_start_prefix = "2001:db8:1700::"
+						ipv6_end_prefix   = "2001:db8:17ff::"
+						ipv6_prefix_bits  = 56
+						name              = "range-1"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "address_type", "BOTH"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "end_addr", "2001:db8:17::2ff"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "ipv6_start_prefix", "2001:db8:1700::"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "ipv6_prefix_bits", "56"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "exclude.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "server_association_type", "NONE"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_range.range1", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "infoblox_ipv6_range.range1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
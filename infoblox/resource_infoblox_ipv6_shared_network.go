@@ -0,0 +1,512 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var ipv6SharedNetworkReturnFields = []string{
+	"comment", "disable", "extattrs", "name", "network_view", "networks", "options", "use_options",
+}
+
+// ipv6SharedNetworkObject is the generated IPv6SharedNetwork, which sends its networks by their references only.
+type ipv6SharedNetworkObject struct {
+	ibclient.IPv6SharedNetwork
+	Networks []map[string]string `json:"networks"`
+}
+
+func newEmptyIpv6SharedNetwork() *ibclient.IPv6SharedNetwork {
+	obj := &ibclient.IPv6SharedNetwork{}
+	obj.SetReturnFields(ipv6SharedNetworkReturnFields)
+
+	return obj
+}
+
+// ipv6NetworkCIDR returns the CIDR of an IPv6 network, set either by its CIDR or by its reference, in the canonical form.
+func ipv6NetworkCIDR(network string) string {
+	cidr := network
+	if strings.HasPrefix(network, "ipv6network/") {
+		// The reference is 'ipv6network/<ID>:<escaped CIDR>/<network view>'.
+		parts := strings.SplitN(network, ":", 2)
+		if len(parts) < 2 {
+			return network
+		}
+		cidr = parts[1]
+		if idx := strings.LastIndex(cidr, "/"); idx > 0 {
+			cidr = cidr[:idx]
+		}
+		if unescaped, err := url.PathUnescape(cidr); err == nil {
+			cidr = unescaped
+		}
+	}
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return network
+	}
+
+	return ipNet.String()
+}
+
+// sameIpv6Networks compares the lists of IPv6 networks regardless of their order and of the way they are set.
+func sameIpv6Networks(oldList, newList []interface{}) bool {
+	if len(oldList) != len(newList) {
+		return false
+	}
+	oldCidrs := make([]string, 0, len(oldList))
+	for _, v := range oldList {
+		oldCidrs = append(oldCidrs, ipv6NetworkCIDR(v.(string)))
+	}
+	newCidrs := make([]string, 0, len(newList))
+	for _, v := range newList {
+		newCidrs = append(newCidrs, ipv6NetworkCIDR(v.(string)))
+	}
+	sort.Strings(oldCidrs)
+	sort.Strings(newCidrs)
+	for i := range oldCidrs {
+		if oldCidrs[i] != newCidrs[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ipv6SharedNetworkRefs finds the references of the networks of an IPv6 shared network, checking that
+// all of them are in the network view of the shared network.
+func ipv6SharedNetworkRefs(conn ibclient.IBConnector, networks []interface{}, networkView string) ([]map[string]string, error) {
+	netObj := &ibclient.Ipv6Network{}
+	netObj.SetReturnFields([]string{"network", "network_view"})
+
+	refs := make([]map[string]string, 0, len(networks))
+	for _, n := range networks {
+		network := n.(string)
+		var res []ibclient.Ipv6Network
+		if strings.HasPrefix(network, "ipv6network/") {
+			var found ibclient.Ipv6Network
+			if err := conn.GetObject(netObj, network, ibclient.NewQueryParams(false, nil), &found); err != nil {
+				return nil, fmt.Errorf("failed to get IPv6 network '%s': %w", network, err)
+			}
+			res = append(res, found)
+		} else {
+			sf := map[string]string{
+				"network":      ipv6NetworkCIDR(network),
+				"network_view": networkView,
+			}
+			if err := conn.GetObject(netObj, "", ibclient.NewQueryParams(false, sf), &res); err != nil {
+				return nil, fmt.Errorf("failed to get IPv6 network '%s': %w", network, err)
+			}
+		}
+		if len(res) == 0 || stringPtrValue(res[0].NetworkView) != networkView {
+			return nil, fmt.Errorf(
+				"IPv6 network '%s' is not found in the network view '%s': all the networks of a shared network must be in its network view",
+				network, networkView)
+		}
+		refs = append(refs, map[string]string{"_ref": res[0].Ref})
+	}
+
+	return refs, nil
+}
+
+func resourceIpv6SharedNetwork() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpv6SharedNetworkCreate,
+		ReadContext:   resourceIpv6SharedNetworkRead,
+		UpdateContext: resourceIpv6SharedNetworkUpdate,
+		DeleteContext: resourceIpv6SharedNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIpv6SharedNetworkImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the IPv6 shared network object.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The comment for the IPv6 shared network object.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the IPv6 shared network to be added/updated, as a map in JSON format.",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "The disable flag for the IPv6 shared network object.",
+			},
+			"networks": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Description: "A list of IPv6 networks belonging to the shared network, set by their CIDRs or references. " +
+					"All of them must be in the network view of the shared network.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					oldVal, newVal := d.GetChange("networks")
+					return sameIpv6Networks(oldVal.([]interface{}), newVal.([]interface{}))
+				},
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "The name of the network view in which this shared network resides.",
+			},
+			"use_options": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Use flag for options.",
+			},
+			"options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "An array of DHCPv6 option structs that lists the DHCP options associated with the object. " +
+					"When defining a DHCP option, at least a ‘name’ or a ‘num’ is required.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the DHCP option.",
+						},
+						"num": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The code of the DHCP option.",
+						},
+						"use_option": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+							Description: "Only applies to special options that are displayed separately from other options and have a use flag, " +
+								"such as `dhcp6.name-servers`.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Value of the DHCP option.",
+						},
+						"vendor_class": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "DHCPv6",
+							Description: "The name of the space this DHCP option is associated to.",
+						},
+					},
+				},
+			},
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+func ipv6SharedNetworkFromResource(d *schema.ResourceData, conn ibclient.IBConnector) (*ipv6SharedNetworkObject, error) {
+	networkView := d.Get("network_view").(string)
+	if networkView == "" {
+		networkView = defaultNetView
+	}
+	networks, err := ipv6SharedNetworkRefs(conn, d.Get("networks").([]interface{}), networkView)
+	if err != nil {
+		return nil, err
+	}
+	options, err := validateDhcpOptions(d.Get("options").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate options: %w", err)
+	}
+
+	name := d.Get("name").(string)
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	useOptions := d.Get("use_options").(bool)
+
+	return &ipv6SharedNetworkObject{
+		IPv6SharedNetwork: ibclient.IPv6SharedNetwork{
+			Name:        &name,
+			Comment:     &comment,
+			Disable:     &disable,
+			NetworkView: networkView,
+			Options:     options,
+			UseOptions:  &useOptions,
+		},
+		Networks: networks,
+	}, nil
+}
+
+func setIpv6SharedNetworkFields(d *schema.ResourceData, obj *ibclient.IPv6SharedNetwork) error {
+	if err := d.Set("name", stringPtrValue(obj.Name)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", stringPtrValue(obj.Comment)); err != nil {
+		return err
+	}
+	if err := d.Set("disable", obj.Disable != nil && *obj.Disable); err != nil {
+		return err
+	}
+	networks := make([]interface{}, 0, len(obj.Networks))
+	for _, network := range obj.Networks {
+		networks = append(networks, ipv6NetworkCIDR(network.Ref))
+	}
+	if err := d.Set("networks", networks); err != nil {
+		return err
+	}
+	if err := d.Set("network_view", obj.NetworkView); err != nil {
+		return err
+	}
+	if err := d.Set("use_options", obj.UseOptions != nil && *obj.UseOptions); err != nil {
+		return err
+	}
+
+	return d.Set("options", convertDhcpOptionsToInterface(obj.Options))
+}
+
+func resourceIpv6SharedNetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	connector := m.(ibclient.IBConnector)
+	obj, err := ipv6SharedNetworkFromResource(d, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	obj.Ea = extAttrs
+
+	ref, err := connector.CreateObject(obj)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to create an IPv6 shared network: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceIpv6SharedNetworkRead(ctx, d, m)
+}
+
+func resourceIpv6SharedNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var obj ibclient.IPv6SharedNetwork
+	if err = getObjectByRefOrInternalId(newEmptyIpv6SharedNetwork(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); ok {
+			d.SetId("")
+			return nil
+		} else {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setIpv6SharedNetworkFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceIpv6SharedNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		if !updateSuccessful {
+			prevName, _ := d.GetChange("name")
+			prevComment, _ := d.GetChange("comment")
+			prevDisable, _ := d.GetChange("disable")
+			prevNetworks, _ := d.GetChange("networks")
+			prevNetworkView, _ := d.GetChange("network_view")
+			prevUseOptions, _ := d.GetChange("use_options")
+			prevOptions, _ := d.GetChange("options")
+			prevExtAttrs, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("name", prevName)
+			_ = d.Set("comment", prevComment)
+			_ = d.Set("disable", prevDisable)
+			_ = d.Set("networks", prevNetworks)
+			_ = d.Set("network_view", prevNetworkView)
+			_ = d.Set("use_options", prevUseOptions)
+			_ = d.Set("options", prevOptions)
+			_ = d.Set("ext_attrs", prevExtAttrs)
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+	if d.HasChange("network_view") {
+		return diagFromErr(fmt.Errorf("changing the value of 'network_view' field is not allowed"))
+	}
+
+	connector := m.(ibclient.IBConnector)
+	obj, err := ipv6SharedNetworkFromResource(d, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	// The network view is not updatable.
+	obj.NetworkView = ""
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var found ibclient.IPv6SharedNetwork
+	if err = getObjectByRefOrInternalId(newEmptyIpv6SharedNetwork(), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	obj.Ea, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := connector.UpdateObject(obj, found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to update IPv6 shared network: %w", err))
+	}
+	updateSuccessful = true
+
+	d.SetId(ref)
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceIpv6SharedNetworkRead(ctx, d, m)
+}
+
+func resourceIpv6SharedNetworkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.IPv6SharedNetwork
+	if err := getObjectByRefOrInternalId(newEmptyIpv6SharedNetwork(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err := m.(ibclient.IBConnector).DeleteObject(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("failed to delete IPv6 shared network: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIpv6SharedNetworkImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	var obj ibclient.IPv6SharedNetwork
+	err := m.(ibclient.IBConnector).GetObject(newEmptyIpv6SharedNetwork(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting IPv6 shared network: %w", err)
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setIpv6SharedNetworkFields(d, &obj); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceIpv6SharedNetworkUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,140 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func TestSameIpv6Networks(t *testing.T) {
+	const ref = "ipv6network/ZG5zLm5ldHdvcmskMjAwMTpkYjg6OjAvNjQvMA:2001%3Adb8%3A%3A/64/default"
+
+	if cidr := ipv6NetworkCIDR(ref); cidr != "2001:db8::/64" {
+		t.Errorf("unexpected CIDR of the reference: %s", cidr)
+	}
+	if cidr := ipv6NetworkCIDR("2001:DB8:0::/64"); cidr != "2001:db8::/64" {
+		t.Errorf("unexpected CIDR: %s", cidr)
+	}
+	if !sameIpv6Networks([]interface{}{ref, "2001:db8:1::/64"}, []interface{}{"2001:db8:1::/64", "2001:db8::/64"}) {
+		t.Errorf("the same networks in another order are reported as different")
+	}
+	if sameIpv6Networks([]interface{}{ref}, []interface{}{"2001:db8:1::/64"}) {
+		t.Errorf("different networks are reported as the same")
+	}
+}
+
+func TestIpv6SharedNetworkRefs(t *testing.T) {
+	const ref = "ipv6network/ZG5zLm5ldHdvcmskMjAwMTpkYjg6OjAvNjQvMQ:2001%3Adb8%3A%3A/64/view2"
+	conn := &testSearchConnector{
+		byRef: map[string]string{
+			ref: `{"_ref": "` + ref + `", "network": "2001:db8::/64", "network_view": "view2"}`,
+		},
+		searched: `[{"_ref": "ipv6network/ZG5z:2001%3Adb8%3A1%3A%3A/64/view2", "network": "2001:db8:1::/64", "network_view": "view2"}]`,
+	}
+
+	refs, err := ipv6SharedNetworkRefs(conn, []interface{}{ref, "2001:db8:1::/64"}, "view2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refs) != 2 || refs[0]["_ref"] != ref || refs[1]["_ref"] != "ipv6network/ZG5z:2001%3Adb8%3A1%3A%3A/64/view2" {
+		t.Errorf("unexpected references: %v", refs)
+	}
+
+	// The network, set by its reference, is in another network view.
+	if _, err = ipv6SharedNetworkRefs(conn, []interface{}{ref}, "default"); err == nil {
+		t.Errorf("expected an error for a network of another network view")
+	}
+
+	conn.searched = "[]"
+	if _, err = ipv6SharedNetworkRefs(conn, []interface{}{"2001:db8:2::/64"}, "view2"); err == nil {
+		t.Errorf("expected an error for a network, which is not found")
+	}
+}
+
+func testAccCheckIpv6SharedNetworkDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_ipv6_shared_network" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.IPv6SharedNetwork
+		err := connector.GetObject(newEmptyIpv6SharedNetwork(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("IPv6 shared network %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceIpv6SharedNetwork(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpv6SharedNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv6_network" "net1" {
+						cidr = "2001:db8:19::/64"
+					}
+					resource "infoblox_ipv6_network" "net2" {
+						cidr = "2001:db8:1a::/64"
+					}
+					resource "infoblox_ipv6_shared_network" "shared1" {
+						name     = "ipv6-shared-network-1"
+						networks = [infoblox_ipv6_network.net1.cidr, infoblox_ipv6_network.net2.cidr]
+						comment  = "IPv6 shared network"
+						options {
+							name  = "domain-name"
+							num   = 24
+							value = "example.com"
+						}
+						use_options = true
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv6_shared_network.shared1", "networks.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_shared_network.shared1", "network_view", "default"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_shared_network.shared1", "options.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_shared_network.shared1", "options.0.vendor_class", "DHCPv6"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_shared_network.shared1", "use_options", "true"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_shared_network.shared1", "ext_attrs", `{"Site":"HQ"}`),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv6_network" "net1" {
+						cidr = "2001:db8:19::/64"
+					}
+					resource "infoblox_ipv6_network" "net2" {
+						cidr = "2001:db8:1a::/64"
+					}
+					resource "infoblox_ipv6_shared_network" "shared1" {
+						name       = "ipv6-shared-network-1"
+						networks   = [infoblox_ipv6_network.net2.cidr]
+						disable    = true
+						depends_on = [infoblox_ipv6_network.net1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv6_shared_network.shared1", "networks.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_shared_network.shared1", "networks.0", "2001:db8:1a::/64"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_shared_network.shared1", "disable", "true"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_shared_network.shared1", "options.#", "0"),
+				),
+			},
+			{
+				ResourceName:            "infoblox_ipv6_shared_network.shared1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
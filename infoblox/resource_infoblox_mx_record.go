@@ -4,20 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func resourceMXRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceMXRecordCreate,
-		Read:   resourceMXRecordGet,
-		Update: resourceMXRecordUpdate,
-		Delete: resourceMXRecordDelete,
+		CreateContext: resourceMXRecordCreate,
+		ReadContext:   resourceMXRecordGet,
+		UpdateContext: resourceMXRecordUpdate,
+		DeleteContext: resourceMXRecordDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceMXRecordImport,
+			StateContext: resourceMXRecordImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -68,6 +70,7 @@ func resourceMXRecord() *schema.Resource {
 				Default:     "",
 				Description: "Extensible attributes of the MX-record to be added/updated, as a map in JSON format.",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -84,26 +87,26 @@ func resourceMXRecord() *schema.Resource {
 	}
 }
 
-func resourceMXRecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourceMXRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	dnsView := d.Get("dns_view").(string)
 
 	fqdn := d.Get("fqdn").(string)
 	if fqdn == "" {
-		return fmt.Errorf("'fqdn' must not be empty")
+		return diagFromErr(fmt.Errorf("'fqdn' must not be empty"))
 	}
 
 	mx := d.Get("mail_exchanger").(string)
 	if mx == "" {
-		return fmt.Errorf("'mail_exchanger' must not be empty")
+		return diagFromErr(fmt.Errorf("'mail_exchanger' must not be empty"))
 	}
 
 	tempInt := d.Get("preference").(int)
 	if err := ibclient.CheckIntRange("preference", tempInt, 0, 65535); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	preference := uint32(tempInt)
 
@@ -114,17 +117,18 @@ func resourceMXRecordCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	var tenantID string
 	tempVal, found := extAttrs[eaNameForTenantId]
 	if found {
@@ -140,26 +144,25 @@ func resourceMXRecordCreate(d *schema.ResourceData, m interface{}) error {
 
 	newRecord, err := objMgr.CreateMXRecord(dnsView, fqdn, mx, preference, ttl, useTtl, comment, extAttrs)
 	if err != nil {
-		return fmt.Errorf("error creating MX-record: %s", err)
+		return diagFromErr(fmt.Errorf("error creating MX-record: %w", err))
 	}
 	d.SetId(newRecord.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", newRecord.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 
 }
 
-func resourceMXRecordGet(d *schema.ResourceData, m interface{}) error {
+func resourceMXRecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rec, err := searchObjectByRefOrInternalId("MX", d, m)
@@ -168,8 +171,8 @@ func resourceMXRecordGet(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		} else {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		}
 	}
 
@@ -179,7 +182,7 @@ func resourceMXRecordGet(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &obj)
 
 	if err != nil {
-		return fmt.Errorf("failed getting MX-Record: %s", err)
+		return diagFromErr(fmt.Errorf("failed getting MX-Record: %w", err))
 	}
 
 	if obj.Ttl != nil {
@@ -190,45 +193,45 @@ func resourceMXRecordGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("dns_view", obj.View); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("fqdn", obj.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("mail_exchanger", obj.MailExchanger); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("preference", obj.Preference); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(obj.Ref)
 
 	return nil
 }
 
-func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceMXRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -243,6 +246,7 @@ func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("dns_view", prevDNSView.(string))
 			_ = d.Set("fqdn", prevFQDN.(string))
@@ -251,13 +255,14 @@ func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 		}
 	}()
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
 	}
 	dnsView := d.Get("dns_view").(string)
 	fqdn := d.Get("fqdn").(string)
@@ -265,7 +270,7 @@ func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	tempInt := d.Get("preference").(int)
 	if err := ibclient.CheckIntRange("preference", tempInt, 0, 65535); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	preference := uint32(tempInt)
 
@@ -277,21 +282,14 @@ func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -304,7 +302,7 @@ func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	mxrec, err := objMgr.GetMXRecordByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read MX Record for update operation: %w", err)
+		return diagFromErr(fmt.Errorf("failed to read MX Record for update operation: %w", err))
 	}
 
 	internalId := d.Get("internal_id").(string)
@@ -318,31 +316,30 @@ func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(mxrec.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rec, err := objMgr.UpdateMXRecord(
 		d.Id(), dnsView, fqdn, mx, preference, ttl, useTtl, comment, newExtAttrs)
 	if err != nil {
-		return fmt.Errorf("error updating MX-Record: %s", err)
+		return diagFromErr(fmt.Errorf("error updating MX-Record: %w", err))
 	}
 	updateSuccessful = true
 	d.SetId(rec.Ref)
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", rec.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func resourceMXRecordDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceMXRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -357,8 +354,8 @@ func resourceMXRecordDelete(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		} else {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		}
 	}
 
@@ -372,17 +369,16 @@ func resourceMXRecordDelete(d *schema.ResourceData, m interface{}) error {
 
 	_, err = objMgr.DeleteMXRecord(mxrec.Ref)
 	if err != nil {
-		return fmt.Errorf("deletion of MX-Record failed: %s", err)
+		return diagFromErr(fmt.Errorf("deletion of MX-Record failed: %w", err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceMXRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceMXRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -398,7 +394,7 @@ func resourceMXRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.Re
 
 	obj, err := objMgr.GetMXRecordByRef(d.Id())
 	if err != nil {
-		return nil, fmt.Errorf("failed getting MX-Record: %s", err)
+		return nil, fmt.Errorf("failed getting MX-Record: %w", err)
 	}
 
 	if obj.Ttl != nil {
@@ -413,11 +409,11 @@ func resourceMXRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.Re
 	}
 
 	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
 		if err != nil {
 			return nil, err
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -440,9 +436,8 @@ func resourceMXRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.Re
 	d.SetId(obj.Ref)
 
 	// Update the resource with EA Terraform Internal ID
-	err = resourceMXRecordUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceMXRecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 
 	return []*schema.ResourceData{d}, nil
@@ -0,0 +1,446 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var namedAclReturnFields = []string{"access_list", "comment", "extattrs", "name"}
+
+func newEmptyNamedAcl() *ibclient.Namedacl {
+	obj := &ibclient.Namedacl{}
+	obj.SetReturnFields(namedAclReturnFields)
+
+	return obj
+}
+
+// accessControlEntrySchema describes an access control entry: an address, a network or a TSIG key,
+// which the access is allowed or denied to.
+func accessControlEntrySchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.Any(
+					validation.IsIPAddress, validation.IsCIDR, validation.StringInSlice([]string{"Any"}, false)),
+				Description: "The IPv4/IPv6 address, the network in CIDR format or 'Any', which the entry applies to.",
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ALLOW",
+				ValidateFunc: validation.StringInSlice([]string{"ALLOW", "DENY"}, false),
+				Description:  "The permission for the address: 'ALLOW' or 'DENY'. A TSIG key may only be allowed.",
+			},
+			"tsig_key_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of the TSIG key, which the entry applies to, instead of an address.",
+			},
+			"tsig_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Sensitive:   true,
+				Description: "The secret of the TSIG key, BASE64-encoded.",
+			},
+			"tsig_key_alg": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "HMAC-MD5",
+				ValidateFunc: validation.StringInSlice([]string{"HMAC-MD5", "HMAC-SHA256"}, false),
+				Description:  "The algorithm of the TSIG key: 'HMAC-MD5' or 'HMAC-SHA256'.",
+			},
+		},
+	}
+}
+
+// validateAccessControlEntries checks the access control entries, which may be checked at plan time.
+// NIOS has no permission for a TSIG key, the key is always allowed.
+func validateAccessControlEntries(entries []interface{}) error {
+	for _, e := range entries {
+		entry, _ := e.(map[string]interface{})
+		if entry == nil {
+			continue
+		}
+		if entry["tsig_key_name"] != "" && entry["permission"] == "DENY" {
+			return fmt.Errorf("'permission' must not be 'DENY' for the TSIG key '%s', a TSIG key may only be allowed",
+				entry["tsig_key_name"])
+		}
+	}
+
+	return nil
+}
+
+func accessControlEntriesFromResource(entries []interface{}) ([]*ibclient.Addressac, error) {
+	if err := validateAccessControlEntries(entries); err != nil {
+		return nil, err
+	}
+
+	res := make([]*ibclient.Addressac, 0, len(entries))
+	for _, e := range entries {
+		entry, _ := e.(map[string]interface{})
+		if entry == nil {
+			return nil, fmt.Errorf("either 'address' or 'tsig_key_name' must be set for an access control entry")
+		}
+		address := entry["address"].(string)
+		tsigKeyName := entry["tsig_key_name"].(string)
+		switch {
+		case address == "" && tsigKeyName == "":
+			return nil, fmt.Errorf("either 'address' or 'tsig_key_name' must be set for an access control entry")
+		case address != "" && tsigKeyName != "":
+			return nil, fmt.Errorf("'address' and 'tsig_key_name' must not be set together for an access control entry")
+		case address != "":
+			res = append(res, &ibclient.Addressac{
+				Address:    address,
+				Permission: entry["permission"].(string),
+			})
+		default:
+			res = append(res, &ibclient.Addressac{
+				TsigKeyName:    tsigKeyName,
+				TsigKey:        entry["tsig_key"].(string),
+				TsigKeyAlg:     entry["tsig_key_alg"].(string),
+				UseTsigKeyName: true,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// convertAccessControlEntriesToInterface converts the access control entries, keeping their order, which is
+// significant. The secret of a TSIG key is kept from the resource's block, as NIOS may not return it.
+func convertAccessControlEntriesToInterface(blocks []interface{}, entries []*ibclient.Addressac) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(entries))
+	for i, e := range entries {
+		permission := e.Permission
+		if permission == "" {
+			permission = "ALLOW"
+		}
+		tsigKeyAlg := e.TsigKeyAlg
+		if tsigKeyAlg == "" {
+			tsigKeyAlg = "HMAC-MD5"
+		}
+		tsigKey := e.TsigKey
+		if i < len(blocks) {
+			if block, _ := blocks[i].(map[string]interface{}); block != nil && tsigKey == "" &&
+				e.TsigKeyName != "" && block["tsig_key_name"] == e.TsigKeyName {
+				tsigKey = block["tsig_key"].(string)
+			}
+		}
+		res = append(res, map[string]interface{}{
+			"address":       e.Address,
+			"permission":    permission,
+			"tsig_key_name": e.TsigKeyName,
+			"tsig_key":      tsigKey,
+			"tsig_key_alg":  tsigKeyAlg,
+		})
+	}
+
+	return res
+}
+
+func resourceNamedAcl() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNamedAclCreate,
+		ReadContext:   resourceNamedAclRead,
+		UpdateContext: resourceNamedAclUpdate,
+		DeleteContext: resourceNamedAclDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNamedAclImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return validateAccessControlEntries(d.Get("access_list").([]interface{}))
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the named ACL.",
+			},
+			"access_list": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The access control entries of the named ACL, in the order they are applied.",
+				Elem:        accessControlEntrySchema(),
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A descriptive comment.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the named ACL to be added/updated, as a map in JSON format.",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+// namedAclFromResource makes a named ACL of the resource's fields, except for the extensible attributes.
+func namedAclFromResource(d *schema.ResourceData) (*ibclient.Namedacl, error) {
+	accessList, err := accessControlEntriesFromResource(d.Get("access_list").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	comment := d.Get("comment").(string)
+
+	obj := &ibclient.Namedacl{
+		Name:       &name,
+		Comment:    &comment,
+		AccessList: accessList,
+	}
+
+	return obj, nil
+}
+
+func setNamedAclFields(d *schema.ResourceData, obj *ibclient.Namedacl) error {
+	if err := d.Set("name", stringPtrValue(obj.Name)); err != nil {
+		return err
+	}
+	accessList := convertAccessControlEntriesToInterface(d.Get("access_list").([]interface{}), obj.AccessList)
+	if err := d.Set("access_list", accessList); err != nil {
+		return err
+	}
+
+	return d.Set("comment", stringPtrValue(obj.Comment))
+}
+
+func resourceNamedAclCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Check if internal_id is set manually
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	obj, err := namedAclFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	obj.Ea = extAttrs
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(obj)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to create named ACL: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceNamedAclRead(ctx, d, m)
+}
+
+func resourceNamedAclRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var obj ibclient.Namedacl
+	if err = getObjectByRefOrInternalId(newEmptyNamedAcl(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setNamedAclFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceNamedAclUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure, in the state file.
+		if !updateSuccessful {
+			prevName, _ := d.GetChange("name")
+			prevAccessList, _ := d.GetChange("access_list")
+			prevComment, _ := d.GetChange("comment")
+			prevExtAttrs, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("access_list", prevAccessList)
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevExtAttrs.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+
+	obj, err := namedAclFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var found ibclient.Namedacl
+	if err = getObjectByRefOrInternalId(newEmptyNamedAcl(), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	obj.Ea, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := connector.UpdateObject(obj, found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to update named ACL: %w", err))
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceNamedAclRead(ctx, d, m)
+}
+
+func resourceNamedAclDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.Namedacl
+	if err := getObjectByRefOrInternalId(newEmptyNamedAcl(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err := m.(ibclient.IBConnector).DeleteObject(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("failed to delete named ACL: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceNamedAclImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	var obj ibclient.Namedacl
+	err := m.(ibclient.IBConnector).GetObject(newEmptyNamedAcl(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting named ACL: %w", err)
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setNamedAclFields(d, &obj); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceNamedAclUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,151 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckNamedAclDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_named_acl" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.Namedacl
+		err := connector.GetObject(newEmptyNamedAcl(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("named ACL %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccessControlEntriesFromResource(t *testing.T) {
+	entry := func(address, permission, tsigKeyName string) map[string]interface{} {
+		return map[string]interface{}{
+			"address":       address,
+			"permission":    permission,
+			"tsig_key_name": tsigKeyName,
+			"tsig_key":      "c2VjcmV0",
+			"tsig_key_alg":  "HMAC-SHA256",
+		}
+	}
+
+	entries, err := accessControlEntriesFromResource([]interface{}{entry("10.0.0.0/8", "DENY", ""), entry("", "ALLOW", "key1")})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if *entries[0] != (ibclient.Addressac{Address: "10.0.0.0/8", Permission: "DENY"}) {
+		t.Errorf("unexpected address entry: %+v", *entries[0])
+	}
+	expectedTsig := ibclient.Addressac{TsigKeyName: "key1", TsigKey: "c2VjcmV0", TsigKeyAlg: "HMAC-SHA256", UseTsigKeyName: true}
+	if *entries[1] != expectedTsig {
+		t.Errorf("unexpected TSIG entry: %+v", *entries[1])
+	}
+
+	invalidEntries := []map[string]interface{}{
+		entry("", "ALLOW", ""),
+		entry("10.0.0.1", "ALLOW", "key1"),
+		entry("", "DENY", "key1"),
+	}
+	for _, invalid := range invalidEntries {
+		if _, err = accessControlEntriesFromResource([]interface{}{invalid}); err == nil {
+			t.Errorf("expected an error for the entry %v", invalid)
+		}
+	}
+}
+
+func TestAccResourceNamedAcl(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNamedAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_named_acl" "acl1" {
+						name = "acc-test-acl"
+						access_list {
+							address = "10.1.1.1"
+							permission = "DENY"
+						}
+						access_list {
+							address = "10.1.0.0/16"
+						}
+						access_list {
+							tsig_key_name = "acc-test-key"
+							tsig_key = "c2VjcmV0LWtleQ=="
+							tsig_key_alg = "HMAC-SHA256"
+						}
+						comment = "acceptance test ACL"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_named_acl.acl1", "name", "acc-test-acl"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.acl1", "access_list.#", "3"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.acl1", "access_list.0.address", "10.1.1.1"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.acl1", "access_list.0.permission", "DENY"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.acl1", "access_list.1.permission", "ALLOW"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.acl1", "access_list.2.tsig_key_name", "acc-test-key"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.acl1", "access_list.2.tsig_key_alg", "HMAC-SHA256"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.acl1", "comment", "acceptance test ACL"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_named_acl" "acl1" {
+						name = "acc-test-acl"
+						access_list {
+							address = "Any"
+							permission = "DENY"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_named_acl.acl1", "access_list.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.acl1", "access_list.0.address", "Any"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.acl1", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "infoblox_named_acl.acl1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+			{
+				Config: `
+					resource "infoblox_named_acl" "acl1" {
+						name = "acc-test-acl"
+						access_list {
+							permission = "DENY"
+						}
+					}`,
+				ExpectError: regexp.MustCompile(`either 'address' or 'tsig_key_name' must be set`),
+			},
+			{
+				Config: `
+					resource "infoblox_named_acl" "acl1" {
+						name = "acc-test-acl"
+						access_list {
+							tsig_key_name = "acc-test-key"
+							tsig_key = "c2VjcmV0LWtleQ=="
+							permission = "DENY"
+						}
+					}`,
+				ExpectError: regexp.MustCompile(`'permission' must not be 'DENY' for the TSIG key`),
+			},
+		},
+	})
+}
@@ -0,0 +1,424 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var naptrRecordReturnFields = []string{
+	"comment", "disable", "extattrs", "flags", "name", "order", "preference", "regexp", "replacement", "services",
+	"ttl", "use_ttl", "view", "zone",
+}
+
+func newEmptyNaptrRecord() *ibclient.RecordNaptr {
+	obj := &ibclient.RecordNaptr{}
+	obj.SetReturnFields(naptrRecordReturnFields)
+
+	return obj
+}
+
+func resourceNAPTRRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNAPTRRecordCreate,
+		ReadContext:   resourceNAPTRRecordGet,
+		UpdateContext: resourceNAPTRRecordUpdate,
+		DeleteContext: resourceNAPTRRecordDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNAPTRRecordImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The domain name of the NAPTR-record, in FQDN format.",
+			},
+			"order": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+				Description:  "The order (0..65535) in which the NAPTR-records must be processed, lower values first.",
+			},
+			"preference": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+				Description:  "The preference (0..65535) of the NAPTR-records with the same order, lower values first.",
+			},
+			"flags": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringInSlice([]string{"", "U", "S", "A", "P"}, false),
+				Description: "The flag which controls the rewriting and interpretation of the fields: " +
+					"'U', 'S', 'A', 'P' or empty.",
+			},
+			"services": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The services and protocols, available at the domain name after the rewrite. Example: 'E2U+sip'.",
+			},
+			"regexp": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The substitution expression, applied to the original string to construct the next domain name to look up.",
+			},
+			"replacement": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ".",
+				Description: "The next domain name to look up, in FQDN format, or '.' when 'regexp' is used. " +
+					"'regexp' and 'replacement' are mutually exclusive.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for the NAPTR-record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the NAPTR-record.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the NAPTR-record to be added/updated, as a map in JSON format.",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+// naptrRecordFromResource makes a NAPTR-record of the resource's fields, except for the DNS view and the extensible attributes.
+func naptrRecordFromResource(d *schema.ResourceData) (*ibclient.RecordNaptr, error) {
+	name := d.Get("name").(string)
+	order := uint32(d.Get("order").(int))
+	preference := uint32(d.Get("preference").(int))
+	flags := d.Get("flags").(string)
+	services := d.Get("services").(string)
+	regexp := d.Get("regexp").(string)
+	replacement := d.Get("replacement").(string)
+	if regexp != "" && replacement != "." {
+		return nil, fmt.Errorf("'regexp' and 'replacement' fields are mutually exclusive, 'replacement' must be '.' when 'regexp' is set")
+	}
+	comment := d.Get("comment").(string)
+
+	ttl, useTtl, err := ttlFromResource(d)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &ibclient.RecordNaptr{
+		Name:        &name,
+		Order:       &order,
+		Preference:  &preference,
+		Flags:       &flags,
+		Services:    &services,
+		Regexp:      &regexp,
+		Replacement: &replacement,
+		Comment:     &comment,
+		Ttl:         &ttl,
+		UseTtl:      &useTtl,
+	}
+
+	return obj, nil
+}
+
+func setNAPTRRecordFields(d *schema.ResourceData, obj *ibclient.RecordNaptr) error {
+	ttl := ttlUndef
+	if obj.Ttl != nil && obj.UseTtl != nil && *obj.UseTtl {
+		ttl = int(*obj.Ttl)
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+	if err := d.Set("order", uint32PtrValue(obj.Order)); err != nil {
+		return err
+	}
+	if err := d.Set("preference", uint32PtrValue(obj.Preference)); err != nil {
+		return err
+	}
+	if err := d.Set("flags", stringPtrValue(obj.Flags)); err != nil {
+		return err
+	}
+	if err := d.Set("services", stringPtrValue(obj.Services)); err != nil {
+		return err
+	}
+	if err := d.Set("regexp", stringPtrValue(obj.Regexp)); err != nil {
+		return err
+	}
+	if err := d.Set("replacement", stringPtrValue(obj.Replacement)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", stringPtrValue(obj.Comment)); err != nil {
+		return err
+	}
+	if err := d.Set("dns_view", obj.View); err != nil {
+		return err
+	}
+
+	return d.Set("name", stringPtrValue(obj.Name))
+}
+
+func resourceNAPTRRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	obj, err := naptrRecordFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	obj.View = d.Get("dns_view").(string)
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	obj.Ea = extAttrs
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(obj)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("creation of NAPTR-record failed: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceNAPTRRecordGet(ctx, d, m)
+}
+
+func resourceNAPTRRecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var obj ibclient.RecordNaptr
+	if err = getObjectByRefOrInternalId(newEmptyNaptrRecord(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setNAPTRRecordFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceNAPTRRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevName, _ := d.GetChange("name")
+			prevOrder, _ := d.GetChange("order")
+			prevPreference, _ := d.GetChange("preference")
+			prevFlags, _ := d.GetChange("flags")
+			prevServices, _ := d.GetChange("services")
+			prevRegexp, _ := d.GetChange("regexp")
+			prevReplacement, _ := d.GetChange("replacement")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("order", prevOrder.(int))
+			_ = d.Set("preference", prevPreference.(int))
+			_ = d.Set("flags", prevFlags.(string))
+			_ = d.Set("services", prevServices.(string))
+			_ = d.Set("regexp", prevRegexp.(string))
+			_ = d.Set("replacement", prevReplacement.(string))
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+	if d.HasChange("dns_view") {
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
+	}
+
+	obj, err := naptrRecordFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var found ibclient.RecordNaptr
+	if err = getObjectByRefOrInternalId(newEmptyNaptrRecord(), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	obj.Ea, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := connector.UpdateObject(obj, found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error updating NAPTR-record: %w", err))
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceNAPTRRecordGet(ctx, d, m)
+}
+
+func resourceNAPTRRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.RecordNaptr
+	if err := getObjectByRefOrInternalId(newEmptyNaptrRecord(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err := m.(ibclient.IBConnector).DeleteObject(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("deletion of NAPTR-record failed: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceNAPTRRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	var obj ibclient.RecordNaptr
+	err := m.(ibclient.IBConnector).GetObject(newEmptyNaptrRecord(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting NAPTR-record: %w", err)
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setNAPTRRecordFields(d, &obj); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceNAPTRRecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
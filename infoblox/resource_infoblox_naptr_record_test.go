@@ -0,0 +1,96 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckNAPTRRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_naptr_record" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.RecordNaptr
+		err := connector.GetObject(newEmptyNaptrRecord(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("NAPTR-record %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceNAPTRRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNAPTRRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "naptr.test.com"
+					}
+					resource "infoblox_naptr_record" "rec1" {
+						name        = "4.3.2.1.naptr.test.com"
+						order       = 100
+						preference  = 10
+						flags       = "U"
+						services    = "E2U+sip"
+						regexp      = "!^.*$!sip:info@naptr.test.com!"
+						ttl         = 3600
+						comment     = "ENUM entry"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+						depends_on = [infoblox_zone_auth.zone1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_naptr_record.rec1", "order", "100"),
+					resource.TestCheckResourceAttr("infoblox_naptr_record.rec1", "preference", "10"),
+					resource.TestCheckResourceAttr("infoblox_naptr_record.rec1", "flags", "U"),
+					resource.TestCheckResourceAttr("infoblox_naptr_record.rec1", "services", "E2U+sip"),
+					resource.TestCheckResourceAttr("infoblox_naptr_record.rec1", "regexp", "!^.*$!sip:info@naptr.test.com!"),
+					resource.TestCheckResourceAttr("infoblox_naptr_record.rec1", "replacement", "."),
+					resource.TestCheckResourceAttr("infoblox_naptr_record.rec1", "ttl", "3600"),
+					resource.TestCheckResourceAttr("infoblox_naptr_record.rec1", "ext_attrs", "{\"Site\":\"HQ\"}"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "naptr.test.com"
+					}
+					resource "infoblox_naptr_record" "rec1" {
+						name        = "naptr.test.com"
+						order       = 10
+						preference  = 20
+						flags       = "S"
+						services    = "SIP+D2U"
+						replacement = "_sip._udp.naptr.test.com"
+						depends_on  = [infoblox_zone_auth.zone1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_naptr_record.rec1", "name", "naptr.test.com"),
+					resource.TestCheckResourceAttr("infoblox_naptr_record.rec1", "order", "10"),
+					resource.TestCheckResourceAttr("infoblox_naptr_record.rec1", "flags", "S"),
+					resource.TestCheckResourceAttr("infoblox_naptr_record.rec1", "regexp", ""),
+					resource.TestCheckResourceAttr("infoblox_naptr_record.rec1", "replacement", "_sip._udp.naptr.test.com"),
+					resource.TestCheckResourceAttr("infoblox_naptr_record.rec1", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "infoblox_naptr_record.rec1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
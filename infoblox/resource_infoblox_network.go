@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
@@ -30,8 +31,9 @@ var (
 func resourceNetwork() *schema.Resource {
 	return &schema.Resource{
 		Importer: &schema.ResourceImporter{
-			State: resourceNetworkImport,
+			StateContext: resourceNetworkImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -123,6 +125,18 @@ func resourceNetwork() *schema.Resource {
 				Default:     "",
 				Description: "The Extensible attributes of the Network",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"inherit_ext_attrs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Keep the extensible attributes, inherited from the parent network container, inherited, and push the ones set by the resource down to the descendant ranges, fixed addresses and host records, which do not set them themselves.",
+			},
+			"inherited_ext_attrs": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The extensible attributes inherited from the parent object, as a map in JSON format. Set when 'inherit_ext_attrs' is true.",
+			},
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -139,10 +153,10 @@ func resourceNetwork() *schema.Resource {
 	}
 }
 
-func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) error {
+func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}, isIPv6 bool) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	networkViewName := d.Get("network_view").(string)
 	parentCidr := d.Get("parent_cidr").(string)
@@ -150,7 +164,7 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 	object := d.Get("object").(string)
 	if nextAvailableFilter == "" {
 		if err := d.Set("object", ""); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	prefixLen := d.Get("allocate_prefix_len").(int)
@@ -158,19 +172,20 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 	reserveIPv4 := d.Get("reserve_ip").(int)
 	reserveIPv6 := d.Get("reserve_ipv6").(int)
 	if reserveIPv6 > 255 || reserveIPv6 < 0 {
-		return fmt.Errorf("reserve_ipv6 value must be in range 0..255")
+		return diagFromErr(fmt.Errorf("reserve_ipv6 value must be in range 0..255"))
 	}
 
 	gateway := d.Get("gateway").(string)
 
 	comment := d.Get("comment").(string)
 
-	extAttrsJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrsJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	// Generate UUID for internal_id and add to the EA
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -192,16 +207,16 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 	if cidr == "" && parentCidr != "" && prefixLen > 1 {
 		_, err := objMgr.GetNetworkContainer(networkViewName, parentCidr, isIPv6, nil)
 		if err != nil {
-			return fmt.Errorf(
-				"Allocation of network block within network container '%s' under network view '%s' failed: %s", parentCidr, networkViewName, err.Error())
+			return diagFromErr(fmt.Errorf(
+				"Allocation of network block within network container '%s' under network view '%s' failed: %w", parentCidr, networkViewName, err))
 		}
 
 		network, err = objMgr.AllocateNetwork(networkViewName, parentCidr, isIPv6, uint(prefixLen), comment, extAttrs)
 		if err != nil {
-			return fmt.Errorf("Allocation of network block failed in network view (%s) : %s", networkViewName, err)
+			return diagFromErr(fmt.Errorf("Allocation of network block failed in network view (%s) : %w", networkViewName, err))
 		}
 		if err = d.Set("cidr", network.Cidr); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 	} else if cidr == "" && nextAvailableFilter != "" && prefixLen > 1 {
@@ -211,12 +226,12 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 		err = json.Unmarshal([]byte(nextAvailableFilter), &eaMap)
 		eaMap["network_view"] = networkViewName
 		if err != nil {
-			return fmt.Errorf("error unmarshalling extra attributes of network container: %s", err)
+			return diagFromErr(fmt.Errorf("error unmarshalling extra attributes of network container: %w", err))
 		}
 
 		network, err = objMgr.AllocateNetworkByEA(networkViewName, isIPv6, comment, extAttrs, eaMap, uint(prefixLen), object)
 		if err != nil {
-			return fmt.Errorf("allocation of network block failed in network with extra attributes (%s) : %s", nextAvailableFilter, err)
+			return diagFromErr(fmt.Errorf("allocation of network block failed in network with extra attributes (%s) : %w", nextAvailableFilter, err))
 		}
 		d.Set("cidr", network.Cidr)
 		d.Set("object", object)
@@ -224,18 +239,18 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 	} else if cidr != "" {
 		network, err = objMgr.CreateNetwork(networkViewName, cidr, isIPv6, comment, extAttrs)
 		if err != nil {
-			return fmt.Errorf("Creation of network block failed in network view (%s) : %s", networkViewName, err)
+			return diagFromErr(fmt.Errorf("Creation of network block failed in network view (%s) : %w", networkViewName, err))
 		}
 	} else {
-		return fmt.Errorf("creation of network block failed: neither cidr nor parentCidr with allocate_prefix_len was specified")
+		return diagFromErr(fmt.Errorf("creation of network block failed: neither cidr nor parentCidr with allocate_prefix_len was specified"))
 	}
 
 	d.SetId(network.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", network.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	autoAllocateGateway := gateway == ""
@@ -243,9 +258,9 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 	if !autoAllocateGateway && gateway != "none" {
 		_, err = objMgr.AllocateIP(networkViewName, network.Cidr, gateway, isIPv6, ZeroMacAddr, "", "", nil, "", "", "", nil, "", false, nil, false)
 		if err != nil {
-			return fmt.Errorf(
-				"reservation of the IP address '%s' in network block '%s' from network view '%s' failed: %s",
-				gateway, network.Cidr, networkViewName, err.Error())
+			return diagFromErr(fmt.Errorf(
+				"reservation of the IP address '%s' in network block '%s' from network view '%s' failed: %w",
+				gateway, network.Cidr, networkViewName, err))
 		}
 	}
 
@@ -255,9 +270,9 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 			newAddr, err := objMgr.AllocateIP(
 				networkViewName, network.Cidr, "", isIPv6, reservedDuid, "", "", nil, "", "", "", nil, "", false, nil, false)
 			if err != nil {
-				return fmt.Errorf(
-					"reservation in network block '%s' from network view '%s' failed: %s",
-					network.Cidr, networkViewName, err.Error())
+				return diagFromErr(fmt.Errorf(
+					"reservation in network block '%s' from network view '%s' failed: %w",
+					network.Cidr, networkViewName, err))
 			}
 			if autoAllocateGateway && i == 1 {
 				gateway = newAddr.IPv6Address
@@ -268,9 +283,9 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 			newAddr, err := objMgr.AllocateIP(
 				networkViewName, network.Cidr, "", isIPv6, ZeroMacAddr, "", "", nil, "", "", "", nil, "", false, nil, false)
 			if err != nil {
-				return fmt.Errorf(
-					"reservation in network block '%s' from network view '%s' failed: %s",
-					network.Cidr, networkViewName, err.Error())
+				return diagFromErr(fmt.Errorf(
+					"reservation in network block '%s' from network view '%s' failed: %w",
+					network.Cidr, networkViewName, err))
 			}
 			if autoAllocateGateway && i == 1 {
 				gateway = newAddr.IPv4Address
@@ -283,13 +298,12 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 	return nil
 }
 
-func resourceNetworkRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	//networkViewName := d.Get("network_view").(string)
 
-	extAttrsJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrsJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	//var tenantID string
@@ -311,51 +325,55 @@ func resourceNetworkRead(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		} else {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		}
 	}
 	var obj *ibclient.Network
 	recJson, _ := json.Marshal(network)
 	err = json.Unmarshal(recJson, &obj)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	delete(extAttrs, eaNameForInternalId)
 
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
+	if err = readInheritedEAs(d, m.(ibclient.IBConnector), obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if obj.NetviewName != "" {
 		if err = d.Set("network_view", obj.NetviewName); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err = d.Set("network_view", defaultNetView); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("cidr", obj.Cidr); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(obj.Ref)
@@ -363,7 +381,7 @@ func resourceNetworkRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
+func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -383,6 +401,7 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 			prevResIPv6, _ := d.GetChange("reserve_ipv6")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("network_view", prevNetView.(string))
 			_ = d.Set("cidr", prevCIDR.(string))
@@ -395,45 +414,39 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 			_ = d.Set("reserve_ipv6", prevResIPv6.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 		}
 	}()
 
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'network_view' field is not allowed"))
 	}
 	if d.HasChange("cidr") {
-		return fmt.Errorf("changing the value of 'cidr' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'cidr' field is not allowed"))
 	}
 	if d.HasChange("reserve_ip") {
-		return fmt.Errorf("changing the value of 'reserve_ip' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'reserve_ip' field is not allowed"))
 	}
 	if d.HasChange("reserve_ipv6") {
-		return fmt.Errorf("changing the value of 'reserve_ipv6' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'reserve_ipv6' field is not allowed"))
 	}
 	if d.HasChange("gateway") {
-		return fmt.Errorf("changing the value of 'gateway' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'gateway' field is not allowed"))
 	}
 	if d.HasChange("filter_params") {
-		return fmt.Errorf("changing the value of 'filter_params' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'filter_params' field is not allowed"))
 	}
 	if d.HasChange("object") {
-		return fmt.Errorf("changing the value of 'object' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'object' field is not allowed"))
 	}
 
 	networkViewName := d.Get("network_view").(string)
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -457,12 +470,12 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 
 	net, err := objMgr.GetNetworkByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read network for update operation: %w", err)
+		return diagFromErr(fmt.Errorf("failed to read network for update operation: %w", err))
 	}
 
 	newExtAttrs, err = mergeEAs(net.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// Set the Terraform Internal ID to the NIOS EA if it is not already set
@@ -476,27 +489,29 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 	newExtAttrs[eaNameForInternalId] = newInternalId.String()
 	Network, err = objMgr.UpdateNetwork(net.Ref, newExtAttrs, comment)
 	if err != nil {
-		return fmt.Errorf("Updation of IP Network under network view '%s' failed: '%s'", networkViewName, err.Error())
+		return diagFromErr(fmt.Errorf("Updation of IP Network under network view '%s' failed: '%w'", networkViewName, err))
+	}
+	if err = updateEAInheritance(d, m, Network.Ref, newExtAttrs, true); err != nil {
+		return diagFromErr(err)
 	}
 	updateSuccessful = true
 	d.SetId(Network.Ref)
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", Network.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func resourceNetworkDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetworkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	networkViewName := d.Get("network_view").(string)
 
-	extAttrsJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrsJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -514,8 +529,8 @@ func resourceNetworkDelete(d *schema.ResourceData, m interface{}) error {
 	network, err := searchObjectByRefOrInternalId("Network", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -527,67 +542,66 @@ func resourceNetworkDelete(d *schema.ResourceData, m interface{}) error {
 	recJson, _ := json.Marshal(network)
 	err = json.Unmarshal(recJson, &net)
 	if err != nil {
-		return fmt.Errorf("failed to read network for delete operation: %w", err)
+		return diagFromErr(fmt.Errorf("failed to read network for delete operation: %w", err))
 	}
 
 	_, err = objMgr.DeleteNetwork(net.Ref)
 	if err != nil {
-		return fmt.Errorf("Deletion of Network block failed from network view(%s): %s", networkViewName, err)
+		return diagFromErr(fmt.Errorf("Deletion of Network block failed from network view(%s): %w", networkViewName, err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceIPv4NetworkCreate(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkCreate(d, m, false)
+func resourceIPv4NetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNetworkCreate(ctx, d, m, false)
 }
 
 func resourceIPv4Network() *schema.Resource {
 	nw := resourceNetwork()
-	nw.Create = resourceIPv4NetworkCreate
-	nw.Read = resourceIPv4NetworkRead
-	nw.Update = resourceNetworkUpdate
-	nw.Delete = resourceNetworkDelete
+	nw.CreateContext = resourceIPv4NetworkCreate
+	nw.ReadContext = resourceIPv4NetworkRead
+	nw.UpdateContext = resourceNetworkUpdate
+	nw.DeleteContext = resourceNetworkDelete
 	return nw
 }
 
-func resourceIPv6NetworkCreate(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkCreate(d, m, true)
+func resourceIPv6NetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNetworkCreate(ctx, d, m, true)
 }
 
 func resourceIPv6Network() *schema.Resource {
 	nw := resourceNetwork()
-	nw.Create = resourceIPv6NetworkCreate
-	nw.Read = resourceIPv6NetworkRead
-	nw.Update = resourceNetworkUpdate
-	nw.Delete = resourceNetworkDelete
+	nw.CreateContext = resourceIPv6NetworkCreate
+	nw.ReadContext = resourceIPv6NetworkRead
+	nw.UpdateContext = resourceNetworkUpdate
+	nw.DeleteContext = resourceNetworkDelete
 	return nw
 }
 
-func resourceIPv4NetworkRead(d *schema.ResourceData, m interface{}) error {
+func resourceIPv4NetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ref := d.Id()
 	if !networkIPv4Regexp.MatchString(ref) {
-		return fmt.Errorf("reference '%s' for 'network' object has an invalid format", ref)
+		return diagFromErr(fmt.Errorf("reference '%s' for 'network' object has an invalid format", ref))
 	}
 
-	return resourceNetworkRead(d, m)
+	return resourceNetworkRead(ctx, d, m)
 }
 
-func resourceIPv6NetworkRead(d *schema.ResourceData, m interface{}) error {
+func resourceIPv6NetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ref := d.Id()
 	if !networkIPv6Regexp.MatchString(ref) {
-		return fmt.Errorf("reference '%s' for 'ipv6network' object has an invalid format", ref)
+		return diagFromErr(fmt.Errorf("reference '%s' for 'ipv6network' object has an invalid format", ref))
 	}
 
-	return resourceNetworkRead(d, m)
+	return resourceNetworkRead(ctx, d, m)
 }
 
-func resourceNetworkImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNetworkImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	networkViewName := d.Get("network_view").(string)
 
-	extAttrsJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrsJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -606,16 +620,23 @@ func resourceNetworkImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 
 	obj, err := objMgr.GetNetworkByRef(d.Id())
 	if err != nil {
-		return nil, fmt.Errorf("getting Network block from network view (%s) failed : %s", networkViewName, err)
+		return nil, fmt.Errorf("getting Network block from network view (%s) failed : %w", networkViewName, err)
 	}
 
+	// Inherited EAs are not managed by the resource.
+	inheritedEAs, err := getInheritedEAs(m.(ibclient.IBConnector), obj.Ref)
+	if err != nil {
+		return nil, err
+	}
+	obj.Ea = withoutInheritedEAs(obj.Ea, inheritedEAs)
+
 	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
 		if err != nil {
 			return nil, err
 		}
 
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -643,9 +664,8 @@ func resourceNetworkImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 	}
 
 	d.SetId(obj.Ref)
-	err = resourceNetworkUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceNetworkUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 
 	return []*schema.ResourceData{d}, nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"regexp"
@@ -17,8 +18,9 @@ var (
 func resourceNetworkContainer() *schema.Resource {
 	return &schema.Resource{
 		Importer: &schema.ResourceImporter{
-			State: resourceNetworkContainerImport,
+			StateContext: resourceNetworkContainerImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -76,6 +78,18 @@ func resourceNetworkContainer() *schema.Resource {
 				Optional:    true,
 				Description: "The Extensible attributes of the network container to be added/updated, as a map in JSON format",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"inherit_ext_attrs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Keep the extensible attributes, inherited from the parent network container, inherited, and push the ones set by the resource down to the descendant networks, ranges and host records, which do not set them themselves.",
+			},
+			"inherited_ext_attrs": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The extensible attributes inherited from the parent object, as a map in JSON format. Set when 'inherit_ext_attrs' is true.",
+			},
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -92,9 +106,9 @@ func resourceNetworkContainer() *schema.Resource {
 	}
 }
 
-func resourceNetworkContainerCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) error {
+func resourceNetworkContainerCreate(ctx context.Context, d *schema.ResourceData, m interface{}, isIPv6 bool) diag.Diagnostics {
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	var (
 		nc  *ibclient.NetworkContainer
@@ -108,12 +122,13 @@ func resourceNetworkContainerCreate(d *schema.ResourceData, m interface{}, isIPv
 	nextAvailableFilter := d.Get("filter_params").(string)
 	comment := d.Get("comment").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return fmt.Errorf("failed to create network container: %w", err)
+		return diagFromErr(fmt.Errorf("failed to create network container: %w", err))
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	// Generate UUID for internal_id and add to the EA
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -134,59 +149,58 @@ func resourceNetworkContainerCreate(d *schema.ResourceData, m interface{}, isIPv
 	if cidr == "" && parentCidr != "" && prefixLen > 1 {
 		_, err = objMgr.GetNetworkContainer(nvName, parentCidr, isIPv6, nil)
 		if err != nil {
-			return fmt.Errorf(
-				"allocation of network block within network container '%s' under network view '%s' failed: %w", parentCidr, nvName, err)
+			return diagFromErr(fmt.Errorf(
+				"allocation of network block within network container '%s' under network view '%s' failed: %w", parentCidr, nvName, err))
 		}
 
 		nc, err = objMgr.AllocateNetworkContainer(nvName, parentCidr, isIPv6, uint(prefixLen), comment, extAttrs)
 		if err != nil {
-			return fmt.Errorf("allocation of network block in network view '%s' failed: %w", nvName, err)
+			return diagFromErr(fmt.Errorf("allocation of network block in network view '%s' failed: %w", nvName, err))
 		}
 		if err = d.Set("cidr", nc.Cidr); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else if cidr == "" && nextAvailableFilter != "" && prefixLen > 1 {
 		var eaMap map[string]string
 		err = json.Unmarshal([]byte(nextAvailableFilter), &eaMap)
 		eaMap["network_view"] = nvName
 		if err != nil {
-			return fmt.Errorf("error unmarshalling extra attributes of network container: %s", err)
+			return diagFromErr(fmt.Errorf("error unmarshalling extra attributes of network container: %w", err))
 		}
 
 		nc, err = objMgr.AllocateNetworkContainerByEA(nvName, isIPv6, comment, extAttrs, eaMap, uint(prefixLen))
 		if err != nil {
-			return fmt.Errorf("allocation of network block failed in network with extra attributes (%s) : %s", nextAvailableFilter, err)
+			return diagFromErr(fmt.Errorf("allocation of network block failed in network with extra attributes (%s) : %w", nextAvailableFilter, err))
 		}
 		if err = d.Set("cidr", nc.Cidr); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 	} else if cidr != "" {
 		nc, err = objMgr.CreateNetworkContainer(nvName, cidr, isIPv6, comment, extAttrs)
 		if err != nil {
-			return fmt.Errorf(
+			return diagFromErr(fmt.Errorf(
 				"creation of IPv6 network container block in network view '%s' failed: %w",
-				nvName, err)
+				nvName, err))
 		}
 	} else {
-		return fmt.Errorf("creation of network block failed: neither cidr nor parentCidr with allocate_prefix_len was specified")
+		return diagFromErr(fmt.Errorf("creation of network block failed: neither cidr nor parentCidr with allocate_prefix_len was specified"))
 	}
 
 	d.SetId(nc.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", nc.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	return nil
 }
 
-func resourceNetworkContainerRead(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceNetworkContainerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return fmt.Errorf("failed to read network containter: %w", err)
+		return diagFromErr(fmt.Errorf("failed to read network containter: %w", err))
 	}
 
 	//var tenantID string
@@ -201,8 +215,8 @@ func resourceNetworkContainerRead(d *schema.ResourceData, m interface{}) error {
 	nc, err := searchObjectByRefOrInternalId("NetworkContainer", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -212,44 +226,48 @@ func resourceNetworkContainerRead(d *schema.ResourceData, m interface{}) error {
 	recJson, _ := json.Marshal(nc)
 	err = json.Unmarshal(recJson, &obj)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	delete(extAttrs, eaNameForInternalId)
 
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
+	if err = readInheritedEAs(d, m.(ibclient.IBConnector), obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("network_view", obj.NetviewName); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("cidr", obj.Cidr); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(obj.Ref)
 
 	return nil
 }
 
-func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetworkContainerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -263,6 +281,7 @@ func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error
 			prevNextAvailableFilter, _ := d.GetChange("filter_params")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("network_view", prevNetView.(string))
 			_ = d.Set("cidr", prevCIDR.(string))
@@ -271,45 +290,39 @@ func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error
 			_ = d.Set("filter_params", prevNextAvailableFilter.(string))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 		}
 	}()
 
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'network_view' field is not allowed"))
 	}
 
 	if d.HasChange("cidr") {
-		return fmt.Errorf("changing the value of 'cidr' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'cidr' field is not allowed"))
 	}
 
 	if d.HasChange("parent_cidr") {
-		return fmt.Errorf("changing the value of 'parent_cidr' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'parent_cidr' field is not allowed"))
 	}
 
 	if d.HasChange("allocate_prefix_len") {
-		return fmt.Errorf("changing the value of 'allocate_prefix_len' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'allocate_prefix_len' field is not allowed"))
 	}
 
 	if d.HasChange("filter_params") {
-		return fmt.Errorf("changing the value of 'filter_params' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'filter_params' field is not allowed"))
 	}
 
 	nvName := d.Get("network_view").(string)
 	cidr := d.Get("cidr").(string)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -329,8 +342,8 @@ func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error
 	newExtAttrs[eaNameForInternalId] = newInternalId.String()
 
 	if cidr == "" || nvName == "" {
-		return fmt.Errorf(
-			"tenant ID, network view's name and CIDR are required to update a network container")
+		return diagFromErr(fmt.Errorf(
+			"tenant ID, network view's name and CIDR are required to update a network container"))
 	}
 
 	connector := m.(ibclient.IBConnector)
@@ -338,12 +351,12 @@ func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error
 
 	nc, err := objMgr.GetNetworkContainerByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read network container for update operation: %w", err)
+		return diagFromErr(fmt.Errorf("failed to read network container for update operation: %w", err))
 	}
 
 	newExtAttrs, err = mergeEAs(nc.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	comment := ""
@@ -354,26 +367,28 @@ func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error
 
 	nc, err = objMgr.UpdateNetworkContainer(d.Id(), newExtAttrs, comment)
 	if err != nil {
-		return fmt.Errorf(
+		return diagFromErr(fmt.Errorf(
 			"failed to update the network container in network view '%s': %w",
-			nvName, err)
+			nvName, err))
+	}
+	if err = updateEAInheritance(d, m, nc.Ref, newExtAttrs, true); err != nil {
+		return diagFromErr(err)
 	}
 	updateSuccessful = true
 	d.SetId(nc.Ref)
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", nc.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	return nil
 }
 
-func resourceNetworkContainerDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceNetworkContainerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return fmt.Errorf("failed to delete network container: %w", err)
+		return diagFromErr(fmt.Errorf("failed to delete network container: %w", err))
 	}
 
 	var tenantID string
@@ -390,8 +405,8 @@ func resourceNetworkContainerDelete(d *schema.ResourceData, m interface{}) error
 	obj, err := searchObjectByRefOrInternalId("NetworkContainer", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -403,12 +418,12 @@ func resourceNetworkContainerDelete(d *schema.ResourceData, m interface{}) error
 	recJson, _ := json.Marshal(obj)
 	err = json.Unmarshal(recJson, &nc)
 	if err != nil {
-		return fmt.Errorf("failed to read network container for deletion: %w", err)
+		return diagFromErr(fmt.Errorf("failed to read network container for deletion: %w", err))
 	}
 
 	if _, err := objMgr.DeleteNetworkContainer(nc.Ref); err != nil {
-		return fmt.Errorf(
-			"deletion of the network container failed: %w", err)
+		return diagFromErr(fmt.Errorf(
+			"deletion of the network container failed: %w", err))
 	}
 
 	return nil
@@ -419,25 +434,25 @@ func resourceNetworkContainerDelete(d *schema.ResourceData, m interface{}) error
 //	return false, nil
 //}
 
-func resourceIPv4NetworkContainerCreate(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkContainerCreate(d, m, false)
+func resourceIPv4NetworkContainerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNetworkContainerCreate(ctx, d, m, false)
 }
 
-func resourceIPv4NetworkContainerRead(d *schema.ResourceData, m interface{}) error {
+func resourceIPv4NetworkContainerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ref := d.Id()
 	if !netContainerIPv4Regexp.MatchString(ref) {
-		return fmt.Errorf("reference '%s' for 'networkcontainer' object has an invalid format", ref)
+		return diagFromErr(fmt.Errorf("reference '%s' for 'networkcontainer' object has an invalid format", ref))
 	}
 
-	return resourceNetworkContainerRead(d, m)
+	return resourceNetworkContainerRead(ctx, d, m)
 }
 
-func resourceIPv4NetworkContainerUpdate(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkContainerUpdate(d, m)
+func resourceIPv4NetworkContainerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNetworkContainerUpdate(ctx, d, m)
 }
 
-func resourceIPv4NetworkContainerDelete(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkContainerDelete(d, m)
+func resourceIPv4NetworkContainerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNetworkContainerDelete(ctx, d, m)
 }
 
 //func resourceIPv4NetworkContainerExists(d *schema.ResourceData, m interface{}) (bool, error) {
@@ -446,34 +461,34 @@ func resourceIPv4NetworkContainerDelete(d *schema.ResourceData, m interface{}) e
 
 func resourceIPv4NetworkContainer() *schema.Resource {
 	nc := resourceNetworkContainer()
-	nc.Create = resourceIPv4NetworkContainerCreate
-	nc.Read = resourceIPv4NetworkContainerRead
-	nc.Update = resourceIPv4NetworkContainerUpdate
-	nc.Delete = resourceIPv4NetworkContainerDelete
+	nc.CreateContext = resourceIPv4NetworkContainerCreate
+	nc.ReadContext = resourceIPv4NetworkContainerRead
+	nc.UpdateContext = resourceIPv4NetworkContainerUpdate
+	nc.DeleteContext = resourceIPv4NetworkContainerDelete
 	//nc.Exists = resourceIPv4NetworkContainerExists
 
 	return nc
 }
 
-func resourceIPv6NetworkContainerCreate(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkContainerCreate(d, m, true)
+func resourceIPv6NetworkContainerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNetworkContainerCreate(ctx, d, m, true)
 }
 
-func resourceIPv6NetworkContainerRead(d *schema.ResourceData, m interface{}) error {
+func resourceIPv6NetworkContainerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ref := d.Id()
 	if !netContainerIPv6Regexp.MatchString(ref) {
-		return fmt.Errorf("reference '%s' for 'ipv6networkcontainer' object has an invalid format", ref)
+		return diagFromErr(fmt.Errorf("reference '%s' for 'ipv6networkcontainer' object has an invalid format", ref))
 	}
 
-	return resourceNetworkContainerRead(d, m)
+	return resourceNetworkContainerRead(ctx, d, m)
 }
 
-func resourceIPv6NetworkContainerUpdate(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkContainerUpdate(d, m)
+func resourceIPv6NetworkContainerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNetworkContainerUpdate(ctx, d, m)
 }
 
-func resourceIPv6NetworkContainerDelete(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkContainerDelete(d, m)
+func resourceIPv6NetworkContainerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNetworkContainerDelete(ctx, d, m)
 }
 
 //func resourceIPv6NetworkContainerExists(d *schema.ResourceData, m interface{}) (bool, error) {
@@ -482,18 +497,17 @@ func resourceIPv6NetworkContainerDelete(d *schema.ResourceData, m interface{}) e
 
 func resourceIPv6NetworkContainer() *schema.Resource {
 	nc := resourceNetworkContainer()
-	nc.Create = resourceIPv6NetworkContainerCreate
-	nc.Read = resourceIPv6NetworkContainerRead
-	nc.Update = resourceIPv6NetworkContainerUpdate
-	nc.Delete = resourceIPv6NetworkContainerDelete
+	nc.CreateContext = resourceIPv6NetworkContainerCreate
+	nc.ReadContext = resourceIPv6NetworkContainerRead
+	nc.UpdateContext = resourceIPv6NetworkContainerUpdate
+	nc.DeleteContext = resourceIPv6NetworkContainerDelete
 	//nc.Exists = resourceIPv6NetworkContainerExists
 
 	return nc
 }
 
-func resourceNetworkContainerImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceNetworkContainerImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read network containter: %w", err)
 	}
@@ -512,13 +526,20 @@ func resourceNetworkContainerImport(d *schema.ResourceData, m interface{}) ([]*s
 		return nil, fmt.Errorf("failed to retrieve network container: %w", err)
 	}
 
+	// Inherited EAs are not managed by the resource.
+	inheritedEAs, err := getInheritedEAs(m.(ibclient.IBConnector), obj.Ref)
+	if err != nil {
+		return nil, err
+	}
+	obj.Ea = withoutInheritedEAs(obj.Ea, inheritedEAs)
+
 	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
 		if err != nil {
 			return nil, err
 		}
 
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -538,9 +559,8 @@ func resourceNetworkContainerImport(d *schema.ResourceData, m interface{}) ([]*s
 	d.SetId(obj.Ref)
 
 	// Set the Terraform Internal ID to the NIOS EA if it is not already set
-	err = resourceNetworkContainerUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceNetworkContainerUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
@@ -474,6 +474,61 @@ func TestAcc_resourceNetwork_ipv4_ea_inheritance(t *testing.T) {
 	})
 }
 
+func TestAcc_resourceNetwork_ipv4_inherit_ext_attrs(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network_container" "nc1" {
+						cidr = "10.20.0.0/16"
+						inherit_ext_attrs = true
+						ext_attrs = jsonencode({
+							"Site" = "Test site"
+						})
+					}
+					resource "infoblox_ipv4_network" "foo" {
+						cidr = "10.20.1.0/24"
+						inherit_ext_attrs = true
+						ext_attrs = jsonencode({
+							"Location" = "Test loc."
+						})
+						depends_on = [infoblox_ipv4_network_container.nc1]
+					}`,
+				Check: resource.TestCheckResourceAttr("infoblox_ipv4_network.foo", "ext_attrs", `{"Location":"Test loc."}`),
+			},
+			// A changed value is pushed down to the network, and is reported as an inherited one.
+			{
+				Config: `
+					resource "infoblox_ipv4_network_container" "nc1" {
+						cidr = "10.20.0.0/16"
+						inherit_ext_attrs = true
+						ext_attrs = jsonencode({
+							"Site" = "Another site"
+						})
+					}
+					resource "infoblox_ipv4_network" "foo" {
+						cidr = "10.20.1.0/24"
+						inherit_ext_attrs = true
+						ext_attrs = jsonencode({
+							"Location" = "Test loc."
+						})
+						depends_on = [infoblox_ipv4_network_container.nc1]
+					}`,
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.foo", "ext_attrs", `{"Location":"Test loc."}`),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.foo", "inherited_ext_attrs", `{"Site":"Another site"}`),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_resourceNetwork_ipv6(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
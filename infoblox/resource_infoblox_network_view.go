@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"regexp"
 
@@ -16,13 +17,14 @@ var (
 
 func resourceNetworkView() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetworkViewCreate,
-		Read:   resourceNetworkViewRead,
-		Update: resourceNetworkViewUpdate,
-		Delete: resourceNetworkViewDelete,
+		CreateContext: resourceNetworkViewCreate,
+		ReadContext:   resourceNetworkViewRead,
+		UpdateContext: resourceNetworkViewUpdate,
+		DeleteContext: resourceNetworkViewDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNetworkViewImport,
+			StateContext: resourceNetworkViewImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -52,6 +54,7 @@ func resourceNetworkView() *schema.Resource {
 				Optional:    true,
 				Description: "The Extensible attributes of the network container to be added/updated, as a map in JSON format",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -68,18 +71,19 @@ func resourceNetworkView() *schema.Resource {
 	}
 }
 
-func resourceNetworkViewCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetworkViewCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	networkView := d.Get("name").(string)
 	comment := d.Get("comment").(string)
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	var tenantID string
 	if tempVal, ok := extAttrs[eaNameForTenantId]; ok {
 		tenantID = tempVal.(string)
@@ -94,31 +98,30 @@ func resourceNetworkViewCreate(d *schema.ResourceData, m interface{}) error {
 
 	nv, err := objMgr.CreateNetworkView(networkView, comment, extAttrs)
 	if err != nil {
-		return fmt.Errorf("Failed to create Network View : %s", err)
+		return diagFromErr(fmt.Errorf("Failed to create Network View : %w", err))
 	}
 	d.SetId(nv.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", nv.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	return nil
 }
 
-func resourceNetworkViewRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetworkViewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	obj, err := searchObjectByRefOrInternalId("NetworkView", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -130,40 +133,40 @@ func resourceNetworkViewRead(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &nv)
 
 	if err != nil {
-		return fmt.Errorf("Failed to get Network View : %s", err.Error())
+		return diagFromErr(fmt.Errorf("Failed to get Network View : %w", err))
 	}
 
 	if !networkViewRegExp.MatchString(nv.Ref) {
-		return fmt.Errorf("reference '%s' for 'networkview' object has an invalid format", nv.Ref)
+		return diagFromErr(fmt.Errorf("reference '%s' for 'networkview' object has an invalid format", nv.Ref))
 	}
 	delete(nv.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(nv.Ea, extAttrs)
+	omittedEAs := omitEAs(nv.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	d.SetId(nv.Ref)
 	if err = d.Set("ref", nv.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("name", nv.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("comment", nv.Comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func resourceNetworkViewUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetworkViewUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -173,30 +176,25 @@ func resourceNetworkViewUpdate(d *schema.ResourceData, m interface{}) error {
 			prevName, _ := d.GetChange("name")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("name", prevName.(string))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 		}
 	}()
 
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 
 	networkView := d.Get("name").(string)
 	comment := d.Get("comment").(string)
 
-	oldExtAttrJSON, newExtAttrJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -209,7 +207,7 @@ func resourceNetworkViewUpdate(d *schema.ResourceData, m interface{}) error {
 
 	nv, err := objMgr.GetNetworkViewByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read network for update operation: %w", err)
+		return diagFromErr(fmt.Errorf("failed to read network for update operation: %w", err))
 	}
 
 	internalId := d.Get("internal_id").(string)
@@ -223,36 +221,35 @@ func resourceNetworkViewUpdate(d *schema.ResourceData, m interface{}) error {
 
 	updExtAttrs, err := mergeEAs(nv.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	nv, err = objMgr.UpdateNetworkView(d.Id(), networkView, comment, updExtAttrs)
 	if err != nil {
-		return fmt.Errorf("Failed to update Network View : %s", err.Error())
+		return diagFromErr(fmt.Errorf("Failed to update Network View : %w", err))
 	}
 	updateSuccessful = true
 
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", nv.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(nv.Ref)
 
 	return nil
 }
 
-func resourceNetworkViewDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetworkViewDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if d.HasChange("name") {
-		return fmt.Errorf("changing the value of 'networkView' field is not recommended")
+		return diagFromErr(fmt.Errorf("changing the value of 'networkView' field is not recommended"))
 	}
 	networkView := d.Get("name").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var tenantID string
 	if tempVal, ok := extAttrs[eaNameForTenantId]; ok {
@@ -262,8 +259,8 @@ func resourceNetworkViewDelete(d *schema.ResourceData, m interface{}) error {
 	obj, err := searchObjectByRefOrInternalId("NetworkView", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -274,22 +271,21 @@ func resourceNetworkViewDelete(d *schema.ResourceData, m interface{}) error {
 	recJson, _ := json.Marshal(obj)
 	err = json.Unmarshal(recJson, &nv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 	_, err = objMgr.DeleteNetworkView(nv.Ref)
 	if err != nil {
-		return fmt.Errorf("Deletion of Network view %s failed: %s", networkView, err.Error())
+		return diagFromErr(fmt.Errorf("Deletion of Network view %s failed: %w", networkView, err))
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceNetworkViewImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceNetworkViewImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -304,7 +300,7 @@ func resourceNetworkViewImport(d *schema.ResourceData, m interface{}) ([]*schema
 
 	obj, err := objMgr.GetNetworkViewByRef(d.Id())
 	if err != nil {
-		return nil, fmt.Errorf("Failed to get Network View : %s", err.Error())
+		return nil, fmt.Errorf("Failed to get Network View : %w", err)
 	}
 
 	if !networkViewRegExp.MatchString(d.Id()) {
@@ -312,11 +308,11 @@ func resourceNetworkViewImport(d *schema.ResourceData, m interface{}) ([]*schema
 	}
 
 	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
 		if err != nil {
 			return nil, err
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -330,9 +326,8 @@ func resourceNetworkViewImport(d *schema.ResourceData, m interface{}) ([]*schema
 	}
 
 	// Update the resource with the EA Terraform Internal ID
-	err = resourceNetworkViewUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceNetworkViewUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
@@ -0,0 +1,577 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var nsGroupReturnFields = []string{
+	"comment", "extattrs", "external_primaries", "external_secondaries", "grid_primary", "grid_secondaries",
+	"is_grid_default", "is_multimaster", "name", "use_external_primary",
+}
+
+// nsGroupObject is the generated Nsgroup, which sends empty lists of name servers,
+// instead of omitting them, so that the name servers may be removed on update.
+type nsGroupObject struct {
+	ibclient.Nsgroup
+	ExternalPrimaries   []ibclient.NameServer    `json:"external_primaries"`
+	ExternalSecondaries []ibclient.NameServer    `json:"external_secondaries"`
+	GridPrimary         []*ibclient.Memberserver `json:"grid_primary"`
+	GridSecondaries     []*ibclient.Memberserver `json:"grid_secondaries"`
+}
+
+func newEmptyNsGroup() *ibclient.Nsgroup {
+	obj := &ibclient.Nsgroup{}
+	obj.SetReturnFields(nsGroupReturnFields)
+
+	return obj
+}
+
+// memberServersSchema describes the Grid members, which serve the zones.
+func memberServersSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the Grid member in FQDN format.",
+				},
+				"stealth": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Determines if the NS record of the member is hidden from DNS queries.",
+				},
+				"grid_replicate": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+					Description: "Determines if the member gets the zone data by the Grid replication, " +
+						"instead of the DNS zone transfers. Ignored for a primary server.",
+				},
+				"lead": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+					Description: "Determines if the member is the lead secondary server, which performs the zone " +
+						"transfers to the other secondary servers. Ignored for a primary server.",
+				},
+			},
+		},
+	}
+}
+
+// externalServersSchema describes the name servers outside of the Grid, which serve the zones.
+func externalServersSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the name server in FQDN format.",
+				},
+				"address": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The IPv4 or IPv6 address of the name server.",
+				},
+				"stealth": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Determines if the NS record of the name server is hidden from DNS queries.",
+				},
+				"tsig_key_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "The name of the TSIG key, which authenticates the zone transfers with the name server.",
+				},
+				"tsig_key": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Sensitive:   true,
+					Description: "The secret of the TSIG key, BASE64-encoded.",
+				},
+				"tsig_key_alg": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "HMAC-MD5",
+					ValidateFunc: validation.StringInSlice([]string{"HMAC-MD5", "HMAC-SHA256"}, false),
+					Description:  "The algorithm of the TSIG key: 'HMAC-MD5' or 'HMAC-SHA256'.",
+				},
+			},
+		},
+	}
+}
+
+func memberServersFromResource(servers []interface{}) []*ibclient.Memberserver {
+	res := make([]*ibclient.Memberserver, 0, len(servers))
+	for _, s := range servers {
+		server, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		res = append(res, &ibclient.Memberserver{
+			Name:          server["name"].(string),
+			Stealth:       server["stealth"].(bool),
+			GridReplicate: server["grid_replicate"].(bool),
+			Lead:          server["lead"].(bool),
+		})
+	}
+
+	return res
+}
+
+func convertMemberServersToInterface(servers []*ibclient.Memberserver) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(servers))
+	for _, s := range servers {
+		res = append(res, map[string]interface{}{
+			"name":           s.Name,
+			"stealth":        s.Stealth,
+			"grid_replicate": s.GridReplicate,
+			"lead":           s.Lead,
+		})
+	}
+
+	return res
+}
+
+func externalServersFromResource(servers []interface{}) []ibclient.NameServer {
+	res := make([]ibclient.NameServer, 0, len(servers))
+	for _, s := range servers {
+		server, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ns := ibclient.NameServer{
+			Name:    server["name"].(string),
+			Address: server["address"].(string),
+			Stealth: server["stealth"].(bool),
+		}
+		if tsigKeyName := server["tsig_key_name"].(string); tsigKeyName != "" {
+			ns.TsigKeyName = tsigKeyName
+			ns.TsigKey = server["tsig_key"].(string)
+			ns.TsigKeyAlg = server["tsig_key_alg"].(string)
+			ns.UseTsigKeyName = true
+		}
+		res = append(res, ns)
+	}
+
+	return res
+}
+
+func convertExternalServersToInterface(servers []ibclient.NameServer) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(servers))
+	for _, s := range servers {
+		tsigKeyAlg := s.TsigKeyAlg
+		if tsigKeyAlg == "" {
+			tsigKeyAlg = "HMAC-MD5"
+		}
+		res = append(res, map[string]interface{}{
+			"name":          s.Name,
+			"address":       s.Address,
+			"stealth":       s.Stealth,
+			"tsig_key_name": s.TsigKeyName,
+			"tsig_key":      s.TsigKey,
+			"tsig_key_alg":  tsigKeyAlg,
+		})
+	}
+
+	return res
+}
+
+// orderServersAsBlocks orders the name servers as the resource's blocks do, matching them by the name and
+// the address, so that the order of the servers at NIOS side makes no difference; the servers, which are not
+// in the blocks, follow them. The secret of a TSIG key is kept from the block, as NIOS may not return it.
+func orderServersAsBlocks(blocks []interface{}, servers []map[string]interface{}) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(servers))
+	used := make([]bool, len(servers))
+	for _, b := range blocks {
+		block, _ := b.(map[string]interface{})
+		if block == nil {
+			continue
+		}
+		for i, server := range servers {
+			if used[i] || server["name"] != block["name"] || server["address"] != block["address"] {
+				continue
+			}
+			if tsigKey, ok := server["tsig_key"]; ok && tsigKey == "" && server["tsig_key_name"] == block["tsig_key_name"] {
+				server["tsig_key"] = block["tsig_key"]
+			}
+			res = append(res, server)
+			used[i] = true
+			break
+		}
+	}
+	for i, server := range servers {
+		if !used[i] {
+			res = append(res, server)
+		}
+	}
+
+	return res
+}
+
+func resourceNsGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsGroupCreate,
+		ReadContext:   resourceNsGroupRead,
+		UpdateContext: resourceNsGroupUpdate,
+		DeleteContext: resourceNsGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNsGroupImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the name server group.",
+			},
+			"grid_primary": memberServersSchema(
+				"The Grid members, which are the primary servers of the group's zones."),
+			"grid_secondaries": memberServersSchema(
+				"The Grid members, which are the secondary servers of the group's zones."),
+			"external_primaries": externalServersSchema(
+				"The name servers outside of the Grid, which are the primary servers of the group's zones."),
+			"external_secondaries": externalServersSchema(
+				"The name servers outside of the Grid, which are the secondary servers of the group's zones."),
+			"is_grid_default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if the group is the default name server group of the Grid.",
+			},
+			"is_multimaster": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Determines if the group has more than one primary server.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A descriptive comment.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the name server group to be added/updated, as a map in JSON format.",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+// nsGroupFromResource makes a name server group of the resource's fields, except for the extensible attributes.
+func nsGroupFromResource(d *schema.ResourceData) (*nsGroupObject, error) {
+	gridPrimary := memberServersFromResource(d.Get("grid_primary").([]interface{}))
+	externalPrimaries := externalServersFromResource(d.Get("external_primaries").([]interface{}))
+	switch {
+	case len(gridPrimary) == 0 && len(externalPrimaries) == 0:
+		return nil, fmt.Errorf("either 'grid_primary' or 'external_primaries' must be set")
+	case len(gridPrimary) > 0 && len(externalPrimaries) > 0:
+		return nil, fmt.Errorf("'grid_primary' and 'external_primaries' must not be set together")
+	}
+
+	name := d.Get("name").(string)
+	comment := d.Get("comment").(string)
+	isGridDefault := d.Get("is_grid_default").(bool)
+	useExternalPrimary := len(externalPrimaries) > 0
+
+	obj := &nsGroupObject{
+		Nsgroup: ibclient.Nsgroup{
+			Name:               &name,
+			Comment:            &comment,
+			IsGridDefault:      &isGridDefault,
+			UseExternalPrimary: &useExternalPrimary,
+		},
+		GridPrimary:         gridPrimary,
+		GridSecondaries:     memberServersFromResource(d.Get("grid_secondaries").([]interface{})),
+		ExternalPrimaries:   externalPrimaries,
+		ExternalSecondaries: externalServersFromResource(d.Get("external_secondaries").([]interface{})),
+	}
+
+	return obj, nil
+}
+
+func setNsGroupFields(d *schema.ResourceData, obj *ibclient.Nsgroup) error {
+	if err := d.Set("name", stringPtrValue(obj.Name)); err != nil {
+		return err
+	}
+	if err := d.Set("grid_primary", orderServersAsBlocks(
+		d.Get("grid_primary").([]interface{}), convertMemberServersToInterface(obj.GridPrimary))); err != nil {
+		return err
+	}
+	if err := d.Set("grid_secondaries", orderServersAsBlocks(
+		d.Get("grid_secondaries").([]interface{}), convertMemberServersToInterface(obj.GridSecondaries))); err != nil {
+		return err
+	}
+	if err := d.Set("external_primaries", orderServersAsBlocks(
+		d.Get("external_primaries").([]interface{}), convertExternalServersToInterface(obj.ExternalPrimaries))); err != nil {
+		return err
+	}
+	if err := d.Set("external_secondaries", orderServersAsBlocks(
+		d.Get("external_secondaries").([]interface{}), convertExternalServersToInterface(obj.ExternalSecondaries))); err != nil {
+		return err
+	}
+	if err := d.Set("is_grid_default", obj.IsGridDefault != nil && *obj.IsGridDefault); err != nil {
+		return err
+	}
+	if err := d.Set("is_multimaster", obj.IsMultimaster); err != nil {
+		return err
+	}
+
+	return d.Set("comment", stringPtrValue(obj.Comment))
+}
+
+func resourceNsGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Check if internal_id is set manually
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	obj, err := nsGroupFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	obj.Ea = extAttrs
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(obj)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to create name server group: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceNsGroupRead(ctx, d, m)
+}
+
+func resourceNsGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var obj ibclient.Nsgroup
+	if err = getObjectByRefOrInternalId(newEmptyNsGroup(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setNsGroupFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceNsGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure, in the state file.
+		if !updateSuccessful {
+			prevName, _ := d.GetChange("name")
+			prevGridPrimary, _ := d.GetChange("grid_primary")
+			prevGridSecondaries, _ := d.GetChange("grid_secondaries")
+			prevExternalPrimaries, _ := d.GetChange("external_primaries")
+			prevExternalSecondaries, _ := d.GetChange("external_secondaries")
+			prevIsGridDefault, _ := d.GetChange("is_grid_default")
+			prevComment, _ := d.GetChange("comment")
+			prevExtAttrs, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("grid_primary", prevGridPrimary)
+			_ = d.Set("grid_secondaries", prevGridSecondaries)
+			_ = d.Set("external_primaries", prevExternalPrimaries)
+			_ = d.Set("external_secondaries", prevExternalSecondaries)
+			_ = d.Set("is_grid_default", prevIsGridDefault.(bool))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevExtAttrs.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+
+	obj, err := nsGroupFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var found ibclient.Nsgroup
+	if err = getObjectByRefOrInternalId(newEmptyNsGroup(), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	obj.Ea, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := connector.UpdateObject(obj, found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to update name server group: %w", err))
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceNsGroupRead(ctx, d, m)
+}
+
+func resourceNsGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.Nsgroup
+	if err := getObjectByRefOrInternalId(newEmptyNsGroup(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err := m.(ibclient.IBConnector).DeleteObject(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("failed to delete name server group: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceNsGroupImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	var obj ibclient.Nsgroup
+	err := m.(ibclient.IBConnector).GetObject(newEmptyNsGroup(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting name server group: %w", err)
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setNsGroupFields(d, &obj); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceNsGroupUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
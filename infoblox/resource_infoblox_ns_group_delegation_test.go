@@ -0,0 +1,76 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckNsGroupDelegationDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_ns_group_delegation" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.NsgroupDelegation
+		err := connector.GetObject(newEmptyNsGroupDelegation(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("delegation name server group %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceNsGroupDelegation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNsGroupDelegationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ns_group_delegation" "nsg1" {
+						name = "acc-test-nsg-delegation"
+						delegate_to {
+							name    = "ns1.partner.com"
+							address = "10.0.0.1"
+						}
+						comment = "acceptance test group"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ns_group_delegation.nsg1", "name", "acc-test-nsg-delegation"),
+					resource.TestCheckResourceAttr("infoblox_ns_group_delegation.nsg1", "delegate_to.0.name", "ns1.partner.com"),
+					resource.TestCheckResourceAttr("infoblox_ns_group_delegation.nsg1", "comment", "acceptance test group"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ns_group_delegation" "nsg1" {
+						name = "acc-test-nsg-delegation"
+						delegate_to {
+							name    = "ns2.partner.com"
+							address = "10.0.0.2"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ns_group_delegation.nsg1", "delegate_to.0.address", "10.0.0.2"),
+					resource.TestCheckResourceAttr("infoblox_ns_group_delegation.nsg1", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "infoblox_ns_group_delegation.nsg1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
@@ -0,0 +1,76 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckNsGroupForwardStubServerDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_ns_group_forward_stub_server" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.NsgroupForwardstubserver
+		err := connector.GetObject(newEmptyNsGroupForwardStubServer(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("forward stub server name server group %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceNsGroupForwardStubServer(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNsGroupForwardStubServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ns_group_forward_stub_server" "nsg1" {
+						name = "acc-test-nsg-forward-stub-server"
+						external_servers {
+							name    = "ns1.partner.com"
+							address = "10.0.0.1"
+						}
+						comment = "acceptance test group"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ns_group_forward_stub_server.nsg1", "name", "acc-test-nsg-forward-stub-server"),
+					resource.TestCheckResourceAttr("infoblox_ns_group_forward_stub_server.nsg1", "external_servers.0.name", "ns1.partner.com"),
+					resource.TestCheckResourceAttr("infoblox_ns_group_forward_stub_server.nsg1", "comment", "acceptance test group"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ns_group_forward_stub_server" "nsg1" {
+						name = "acc-test-nsg-forward-stub-server"
+						external_servers {
+							name    = "ns2.partner.com"
+							address = "10.0.0.2"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ns_group_forward_stub_server.nsg1", "external_servers.0.address", "10.0.0.2"),
+					resource.TestCheckResourceAttr("infoblox_ns_group_forward_stub_server.nsg1", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "infoblox_ns_group_forward_stub_server.nsg1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
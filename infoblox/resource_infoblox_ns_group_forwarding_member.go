@@ -0,0 +1,359 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var nsGroupForwardingMemberReturnFields = []string{"comment", "extattrs", "forwarding_servers", "name"}
+
+func newEmptyNsGroupForwardingMember() *ibclient.NsgroupForwardingmember {
+	obj := &ibclient.NsgroupForwardingmember{}
+	obj.SetReturnFields(nsGroupForwardingMemberReturnFields)
+
+	return obj
+}
+
+func resourceNsGroupForwardingMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsGroupForwardingMemberCreate,
+		ReadContext:   resourceNsGroupForwardingMemberRead,
+		UpdateContext: resourceNsGroupForwardingMemberUpdate,
+		DeleteContext: resourceNsGroupForwardingMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNsGroupForwardingMemberImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the forwarding member name server group.",
+			},
+			"forwarding_servers": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The Grid members, which forward the queries of the group's zones.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of this Grid member in FQDN format.",
+						},
+						"forwarders_only": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Determines if the appliance sends queries to forwarders only, and not to other internal or Internet root servers.",
+						},
+						"use_override_forwarders": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Determines if the appliance sends queries to name servers.",
+						},
+						"forward_to": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The information for the remote name servers to which you want the Infoblox appliance to forward queries for a specified domain name.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The IP address of the remote name server to which you want the Infoblox appliance to forward queries for a specified domain name.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the remote name server to which you want the Infoblox appliance to forward queries for a specified domain name.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A descriptive comment.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the forwarding member name server group to be added/updated, as a map in JSON format.",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+// nsGroupForwardingMemberFromResource makes a forwarding member name server group of the resource's fields, except for the extensible attributes.
+func nsGroupForwardingMemberFromResource(d *schema.ResourceData) (*ibclient.NsgroupForwardingmember, error) {
+	forwardingServers, err := validateForwardingServers(d.Get("forwarding_servers").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	comment := d.Get("comment").(string)
+
+	obj := &ibclient.NsgroupForwardingmember{
+		Name:              &name,
+		Comment:           &comment,
+		ForwardingServers: forwardingServers,
+	}
+
+	return obj, nil
+}
+
+func setNsGroupForwardingMemberFields(d *schema.ResourceData, obj *ibclient.NsgroupForwardingmember) error {
+	if err := d.Set("name", stringPtrValue(obj.Name)); err != nil {
+		return err
+	}
+	servers, err := convertForwardingServersToInterface(obj.ForwardingServers)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("forwarding_servers", servers); err != nil {
+		return err
+	}
+
+	return d.Set("comment", stringPtrValue(obj.Comment))
+}
+
+func resourceNsGroupForwardingMemberCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Check if internal_id is set manually
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	obj, err := nsGroupForwardingMemberFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	obj.Ea = extAttrs
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(obj)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to create forwarding member name server group: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceNsGroupForwardingMemberRead(ctx, d, m)
+}
+
+func resourceNsGroupForwardingMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var obj ibclient.NsgroupForwardingmember
+	if err = getObjectByRefOrInternalId(newEmptyNsGroupForwardingMember(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setNsGroupForwardingMemberFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceNsGroupForwardingMemberUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure, in the state file.
+		if !updateSuccessful {
+			prevName, _ := d.GetChange("name")
+			prevServers, _ := d.GetChange("forwarding_servers")
+			prevComment, _ := d.GetChange("comment")
+			prevExtAttrs, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("forwarding_servers", prevServers)
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevExtAttrs.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+
+	obj, err := nsGroupForwardingMemberFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var found ibclient.NsgroupForwardingmember
+	if err = getObjectByRefOrInternalId(newEmptyNsGroupForwardingMember(), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	obj.Ea, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := connector.UpdateObject(obj, found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to update forwarding member name server group: %w", err))
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceNsGroupForwardingMemberRead(ctx, d, m)
+}
+
+func resourceNsGroupForwardingMemberDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.NsgroupForwardingmember
+	if err := getObjectByRefOrInternalId(newEmptyNsGroupForwardingMember(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err := m.(ibclient.IBConnector).DeleteObject(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("failed to delete forwarding member name server group: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceNsGroupForwardingMemberImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	var obj ibclient.NsgroupForwardingmember
+	err := m.(ibclient.IBConnector).GetObject(newEmptyNsGroupForwardingMember(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting forwarding member name server group: %w", err)
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setNsGroupForwardingMemberFields(d, &obj); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceNsGroupForwardingMemberUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
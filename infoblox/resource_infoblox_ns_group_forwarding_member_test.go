@@ -0,0 +1,80 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckNsGroupForwardingMemberDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_ns_group_forwarding_member" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.NsgroupForwardingmember
+		err := connector.GetObject(newEmptyNsGroupForwardingMember(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("forwarding member name server group %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceNsGroupForwardingMember(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNsGroupForwardingMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ns_group_forwarding_member" "nsg1" {
+						name = "acc-test-nsg-forwarding-member"
+						forwarding_servers {
+							name = "infoblox.localdomain"
+						}
+						comment = "acceptance test group"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ns_group_forwarding_member.nsg1", "name", "acc-test-nsg-forwarding-member"),
+					resource.TestCheckResourceAttr("infoblox_ns_group_forwarding_member.nsg1", "forwarding_servers.0.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr("infoblox_ns_group_forwarding_member.nsg1", "comment", "acceptance test group"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ns_group_forwarding_member" "nsg1" {
+						name = "acc-test-nsg-forwarding-member"
+						forwarding_servers {
+							name                    = "infoblox.localdomain"
+							forwarders_only         = true
+							use_override_forwarders = true
+							forward_to {
+								name    = "fwd1.partner.com"
+								address = "10.0.0.1"
+							}
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ns_group_forwarding_member.nsg1", "forwarding_servers.0.forward_to.0.address", "10.0.0.1"),
+					resource.TestCheckResourceAttr("infoblox_ns_group_forwarding_member.nsg1", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "infoblox_ns_group_forwarding_member.nsg1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
@@ -0,0 +1,74 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckNsGroupStubMemberDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_ns_group_stub_member" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.NsgroupStubmember
+		err := connector.GetObject(newEmptyNsGroupStubMember(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("stub member name server group %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceNsGroupStubMember(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNsGroupStubMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ns_group_stub_member" "nsg1" {
+						name = "acc-test-nsg-stub-member"
+						stub_members {
+							name = "infoblox.localdomain"
+						}
+						comment = "acceptance test group"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ns_group_stub_member.nsg1", "name", "acc-test-nsg-stub-member"),
+					resource.TestCheckResourceAttr("infoblox_ns_group_stub_member.nsg1", "stub_members.0.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr("infoblox_ns_group_stub_member.nsg1", "comment", "acceptance test group"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ns_group_stub_member" "nsg1" {
+						name = "acc-test-nsg-stub-member"
+						stub_members {
+							name = "infoblox.localdomain"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ns_group_stub_member.nsg1", "stub_members.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_ns_group_stub_member.nsg1", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "infoblox_ns_group_stub_member.nsg1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
@@ -0,0 +1,112 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckNsGroupDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_ns_group" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.Nsgroup
+		err := connector.GetObject(newEmptyNsGroup(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("name server group %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceNsGroup(t *testing.T) {
+	tsigKeyConfig := `
+					resource "infoblox_ns_group" "nsg1" {
+						name = "acc-test-nsg"
+						grid_primary {
+							name = "infoblox.localdomain"
+						}
+						external_secondaries {
+							name          = "ns2.partner.com"
+							address       = "10.0.0.2"
+							tsig_key_name = "partner-key"
+							tsig_key      = "X4oRe92t54I+T98NdQpV2w=="
+						}
+						comment = "acceptance test group"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNsGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tsigKeyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ns_group.nsg1", "name", "acc-test-nsg"),
+					resource.TestCheckResourceAttr("infoblox_ns_group.nsg1", "grid_primary.0.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr("infoblox_ns_group.nsg1", "external_secondaries.0.address", "10.0.0.2"),
+					resource.TestCheckResourceAttr("infoblox_ns_group.nsg1", "external_secondaries.0.tsig_key_name", "partner-key"),
+					resource.TestCheckResourceAttr("infoblox_ns_group.nsg1", "external_secondaries.0.tsig_key_alg", "HMAC-MD5"),
+					resource.TestCheckResourceAttr("infoblox_ns_group.nsg1", "is_grid_default", "false"),
+					resource.TestCheckResourceAttr("infoblox_ns_group.nsg1", "is_multimaster", "false"),
+				),
+			},
+			// NIOS does not return the secret of the TSIG key, it is kept from the configuration.
+			{
+				Config:   tsigKeyConfig,
+				PlanOnly: true,
+			},
+			{
+				Config: `
+					resource "infoblox_ns_group" "nsg1" {
+						name = "acc-test-nsg"
+						grid_primary {
+							name    = "infoblox.localdomain"
+							stealth = true
+						}
+						external_secondaries {
+							name    = "ns3.partner.com"
+							address = "10.0.0.3"
+							stealth = true
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ns_group.nsg1", "grid_primary.0.stealth", "true"),
+					resource.TestCheckResourceAttr("infoblox_ns_group.nsg1", "external_secondaries.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_ns_group.nsg1", "external_secondaries.0.name", "ns3.partner.com"),
+					resource.TestCheckResourceAttr("infoblox_ns_group.nsg1", "external_secondaries.0.tsig_key_name", ""),
+					resource.TestCheckResourceAttr("infoblox_ns_group.nsg1", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "infoblox_ns_group.nsg1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+			{
+				Config: `
+					resource "infoblox_ns_group" "nsg1" {
+						name = "acc-test-nsg"
+						external_secondaries {
+							name    = "ns3.partner.com"
+							address = "10.0.0.3"
+						}
+					}`,
+				ExpectError: regexp.MustCompile("either 'grid_primary' or 'external_primaries' must be set"),
+			},
+		},
+	})
+}
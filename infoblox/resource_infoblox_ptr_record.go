@@ -4,20 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func resourcePTRRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePTRRecordCreate,
-		Read:   resourcePTRRecordGet,
-		Update: resourcePTRRecordUpdate,
-		Delete: resourcePTRRecordDelete,
+		CreateContext: resourcePTRRecordCreate,
+		ReadContext:   resourcePTRRecordGet,
+		UpdateContext: resourcePTRRecordUpdate,
+		DeleteContext: resourcePTRRecordDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourcePTRRecordImport,
+			StateContext: resourcePTRRecordImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -81,6 +83,7 @@ func resourcePTRRecord() *schema.Resource {
 				Optional:    true,
 				Description: "The Extensible attributes of PTR record to be added/updated, as a map in JSON format",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -97,15 +100,15 @@ func resourcePTRRecord() *schema.Resource {
 	}
 }
 
-func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourcePTRRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	networkView, trimmed := checkAndTrimSpaces(d.Get("network_view").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "network_view")
+		return diagFromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "network_view"))
 	}
 	if networkView == "" {
 		networkView = defaultNetView
@@ -115,7 +118,7 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 
 	cidr, trimmed := checkAndTrimSpaces(d.Get("cidr").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "cidr")
+		return diagFromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "cidr"))
 	}
 	if cidr != "" {
 		ipAddrSrcCounter = ipAddrSrcCounter + 1
@@ -123,7 +126,7 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 
 	ipAddr, trimmed := checkAndTrimSpaces(d.Get("ip_addr").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "ip_addr")
+		return diagFromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "ip_addr"))
 	}
 	if ipAddr != "" {
 		ipAddrSrcCounter = ipAddrSrcCounter + 1
@@ -131,29 +134,30 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 
 	dnsViewName, trimmed := checkAndTrimSpaces(d.Get("dns_view").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "dns_view")
+		return diagFromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "dns_view"))
 	}
 
 	ptrdname, trimmed := checkAndTrimSpaces(d.Get("ptrdname").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "ptrdname")
+		return diagFromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "ptrdname"))
 	}
 
 	recordName, trimmed := checkAndTrimSpaces(d.Get("record_name").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "record_name")
+		return diagFromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "record_name"))
 	}
 	if recordName != "" {
 		ipAddrSrcCounter = ipAddrSrcCounter + 1
 	}
 
 	comment := d.Get("comment").(string)
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	// Generate internal ID and add it to the extensible attributes
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -164,14 +168,14 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if ipAddrSrcCounter == 0 {
-		return fmt.Errorf(
+		return diagFromErr(fmt.Errorf(
 			"'ip_addr' or 'cidr' are mandatory in reverse mapping zone " +
-				"and 'record_name' is mandatory in forward mapping zone")
+				"and 'record_name' is mandatory in forward mapping zone"))
 	}
 
 	if ipAddrSrcCounter != 1 {
-		return fmt.Errorf(
-			"only one of 'ip_addr', 'cidr' and 'record_name' must be defined")
+		return diagFromErr(fmt.Errorf(
+			"only one of 'ip_addr', 'cidr' and 'record_name' must be defined"))
 	}
 
 	var ttl uint32
@@ -182,7 +186,7 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	connector := m.(ibclient.IBConnector)
@@ -200,7 +204,7 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 		comment,
 		extAttrs)
 	if err != nil {
-		return fmt.Errorf("creation of PTR-record under the DNS view '%s' failed: %s", dnsViewName, err)
+		return diagFromErr(fmt.Errorf("creation of PTR-record under the DNS view '%s' failed: %w", dnsViewName, err))
 	}
 
 	// After reading a newly created object, IP address will be
@@ -212,32 +216,32 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if err = d.Set("ref", recordPTR.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("ip_addr", ipAddr); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("record_name", recordPTR.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if val, ok := d.GetOk("network_view"); !ok || val.(string) == "" {
 		dnsViewObj, err := objMgr.GetDNSView(dnsViewName)
 		if err != nil {
-			return fmt.Errorf(
+			return diagFromErr(fmt.Errorf(
 				"error while retrieving information about DNS view '%s': %s",
-				dnsViewName, err)
+				dnsViewName, err))
 		}
 		if err = d.Set("network_view", dnsViewObj.NetworkView); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("comment", comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(recordPTR.Ref)
@@ -245,12 +249,11 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
+func resourcePTRRecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -264,8 +267,8 @@ func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("PTR", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -278,7 +281,7 @@ func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &obj)
 
 	if err != nil && obj.Ref != "" {
-		return fmt.Errorf("getting PTR-record with ID '%s' failed: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf("getting PTR-record with ID '%s' failed: %w", d.Id(), err))
 	}
 
 	if obj.Ttl != nil {
@@ -289,46 +292,46 @@ func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	delete(obj.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("dns_view", obj.View); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if val, ok := d.GetOk("network_view"); !ok || val.(string) == "" {
 		dnsView, err := objMgr.GetDNSView(obj.View)
 		if err != nil {
-			return fmt.Errorf(
+			return diagFromErr(fmt.Errorf(
 				"error while retrieving information about DNS view '%s': %s",
-				obj.View, err)
+				obj.View, err))
 		}
 		if err = d.Set("network_view", dnsView.NetworkView); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("ptrdname", obj.PtrdName); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var ipAddr string
@@ -338,10 +341,10 @@ func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
 		ipAddr = *obj.Ipv6Addr
 	}
 	if err = d.Set("ip_addr", ipAddr); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("record_name", obj.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(obj.Ref)
@@ -349,7 +352,7 @@ func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourcePTRRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -365,6 +368,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("network_view", prevNetView.(string))
 			_ = d.Set("dns_view", prevDNSView.(string))
@@ -375,19 +379,20 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 		}
 	}()
 
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 
 	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'network_view' field is not allowed"))
 	}
 
 	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
 	}
 
 	networkView := d.Get("network_view").(string)
@@ -399,7 +404,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	recordName, trimmed := checkAndTrimSpaces(d.Get("record_name").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "record_name")
+		return diagFromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "record_name"))
 	}
 	if recordName != "" {
 		ipAddrSrcCounter = ipAddrSrcCounter + 1
@@ -410,7 +415,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	ipAddr, trimmed := checkAndTrimSpaces(d.Get("ip_addr").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "ip_addr")
+		return diagFromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "ip_addr"))
 	}
 	if ipAddr != "" {
 		ipAddrSrcCounter = ipAddrSrcCounter + 1
@@ -422,7 +427,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	cidr, trimmed := checkAndTrimSpaces(d.Get("cidr").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "cidr")
+		return diagFromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "cidr"))
 	}
 	if cidr != "" {
 		ipAddrSrcCounter = ipAddrSrcCounter + 1
@@ -442,26 +447,19 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if ipAddrSrcCounter == 0 {
-		return fmt.Errorf(
-			"'ip_addr' or 'cidr' are mandatory in reverse mapping zone and 'record_name' is mandatory in forward mapping zone")
+		return diagFromErr(fmt.Errorf(
+			"'ip_addr' or 'cidr' are mandatory in reverse mapping zone and 'record_name' is mandatory in forward mapping zone"))
 	}
 
 	if ipAddrSrcChangesCounter > 1 {
-		return fmt.Errorf("only one of 'ip_addr', 'cidr' and 'record_name' must be defined")
+		return diagFromErr(fmt.Errorf("only one of 'ip_addr', 'cidr' and 'record_name' must be defined"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
-	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -477,7 +475,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	connector := m.(ibclient.IBConnector)
@@ -488,7 +486,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	if cidr == "" && ipAddr == "" {
 		recordPTR, err := objMgr.GetPTRRecordByRef(d.Id())
 		if err != nil {
-			return fmt.Errorf("getting PTR-record with ID '%s' failed: %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf("getting PTR-record with ID '%s' failed: %w", d.Id(), err))
 		}
 
 		ipv4 := recordPTR.Ipv4Addr
@@ -502,7 +500,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	ptrrec, err := objMgr.GetPTRRecordByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read PTR Record for update operation: %w", err)
+		return diagFromErr(fmt.Errorf("failed to read PTR Record for update operation: %w", err))
 	}
 
 	internalId := d.Get("internal_id").(string)
@@ -516,12 +514,12 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(ptrrec.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	recordPTRUpdated, err := objMgr.UpdatePTRRecord(d.Id(), networkView, ptrdname, recordName, cidr, ipAddr, useTtl, ttl, comment, newExtAttrs)
 	if err != nil {
-		return fmt.Errorf("update operaiton failed for the PTR-record with ID '%s' under the DNS view '%s': %s", d.Id(), dnsView, err)
+		return diagFromErr(fmt.Errorf("update operaiton failed for the PTR-record with ID '%s' under the DNS view '%s': %w", d.Id(), dnsView, err))
 	}
 	updateSuccessful = true
 	d.SetId(recordPTRUpdated.Ref)
@@ -535,29 +533,28 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if err = d.Set("ref", recordPTRUpdated.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("ip_addr", ipAddr); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("record_name", recordPTRUpdated.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func resourcePTRRecordDelete(d *schema.ResourceData, m interface{}) error {
+func resourcePTRRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	dnsView := d.Get("dns_view").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -571,8 +568,8 @@ func resourcePTRRecordDelete(d *schema.ResourceData, m interface{}) error {
 	ptrrec, err := searchObjectByRefOrInternalId("PTR", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -585,7 +582,7 @@ func resourcePTRRecordDelete(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &obj)
 
 	if err != nil {
-		return fmt.Errorf("getting PTR Record with ID: %s failed: %w", d.Id(), err)
+		return diagFromErr(fmt.Errorf("getting PTR Record with ID: %s failed: %w", d.Id(), err))
 	}
 
 	_, err = objMgr.DeletePTRRecord(obj.Ref)
@@ -593,16 +590,15 @@ func resourcePTRRecordDelete(d *schema.ResourceData, m interface{}) error {
 		if isNotFoundError(err) {
 			d.SetId("")
 		}
-		return fmt.Errorf("deletion of PTR-record with ID '%s'under the DNS view '%s' failed: %s", d.Id(), dnsView, err)
+		return diagFromErr(fmt.Errorf("deletion of PTR-record with ID '%s'under the DNS view '%s' failed: %w", d.Id(), dnsView, err))
 	}
 
 	return nil
 }
 
-func resourcePTRRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourcePTRRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -617,7 +613,7 @@ func resourcePTRRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 
 	obj, err := objMgr.GetPTRRecordByRef(d.Id())
 	if err != nil {
-		return nil, fmt.Errorf("getting PTR-record with ID '%s' failed: %s", d.Id(), err)
+		return nil, fmt.Errorf("getting PTR-record with ID '%s' failed: %w", d.Id(), err)
 	}
 
 	if obj.Ttl != nil {
@@ -638,11 +634,11 @@ func resourcePTRRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 	}
 
 	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
 		if err != nil {
 			return nil, err
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -685,9 +681,8 @@ func resourcePTRRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 
 	d.SetId(obj.Ref)
 
-	err = resourcePTRRecordUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourcePTRRecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 
 	return []*schema.ResourceData{d}, nil
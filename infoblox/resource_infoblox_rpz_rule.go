@@ -0,0 +1,521 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var rpzRuleReturnFields = []string{
+	"canonical", "comment", "disable", "extattrs", "name", "rp_zone", "ttl", "use_ttl", "view", "zone",
+}
+
+const rpzPassthruCanonical = "rpz-passthru"
+
+// newEmptyRpzRule returns the RPZ CNAME object, which implements the rules of the trigger:
+// the domain name of a query, the IP address of a response or the IP address of a client.
+func newEmptyRpzRule(trigger string) ibclient.IBObject {
+	var obj ibclient.IBObject
+	switch trigger {
+	case "IP_ADDRESS":
+		obj = &ibclient.RecordRpzCnameIpaddress{}
+	case "CLIENT_IP_ADDRESS":
+		obj = &ibclient.RecordRpzCnameClientipaddress{}
+	default:
+		obj = &ibclient.RecordRpzCname{}
+	}
+	obj.SetReturnFields(rpzRuleReturnFields)
+
+	return obj
+}
+
+// rpzRuleObject converts the rule to the RPZ CNAME object of the trigger.
+func rpzRuleObject(trigger string, rule *ibclient.RecordRpzCname) ibclient.IBObject {
+	switch trigger {
+	case "IP_ADDRESS":
+		return &ibclient.RecordRpzCnameIpaddress{
+			Canonical: rule.Canonical, Comment: rule.Comment, Disable: rule.Disable, Ea: rule.Ea, Name: rule.Name,
+			RpZone: rule.RpZone, Ttl: rule.Ttl, UseTtl: rule.UseTtl, View: rule.View,
+		}
+	case "CLIENT_IP_ADDRESS":
+		return &ibclient.RecordRpzCnameClientipaddress{
+			Canonical: rule.Canonical, Comment: rule.Comment, Disable: rule.Disable, Ea: rule.Ea, Name: rule.Name,
+			RpZone: rule.RpZone, Ttl: rule.Ttl, UseTtl: rule.UseTtl, View: rule.View,
+		}
+	default:
+		return rule
+	}
+}
+
+// rpzCanonicalFromRuleType returns the canonical name, which implements the rule type in NIOS.
+func rpzCanonicalFromRuleType(trigger, ruleType, name, substituteName string) (string, error) {
+	if ruleType == "SUBSTITUTE" {
+		if substituteName == "" {
+			return "", fmt.Errorf("'substitute_name' field is required for the 'SUBSTITUTE' rule type")
+		}
+		if trigger == "CLIENT_IP_ADDRESS" {
+			return "", fmt.Errorf("the 'SUBSTITUTE' rule type is not allowed for the 'CLIENT_IP_ADDRESS' trigger")
+		}
+	} else if substituteName != "" {
+		return "", fmt.Errorf("'substitute_name' field is allowed for the 'SUBSTITUTE' rule type only")
+	}
+
+	switch ruleType {
+	case "NXDOMAIN":
+		return "", nil
+	case "NODATA":
+		return "*", nil
+	case "PASSTHRU":
+		if trigger == "QNAME" {
+			return name, nil
+		}
+		return rpzPassthruCanonical, nil
+	case "SUBSTITUTE":
+		return substituteName, nil
+	}
+
+	return "", fmt.Errorf("unsupported rule type '%s'", ruleType)
+}
+
+// rpzRuleTypeFromCanonical returns the rule type and the substitute name, which the canonical name stands for.
+func rpzRuleTypeFromCanonical(name, canonical string) (string, string) {
+	switch canonical {
+	case "":
+		return "NXDOMAIN", ""
+	case "*":
+		return "NODATA", ""
+	case name, rpzPassthruCanonical:
+		return "PASSTHRU", ""
+	}
+
+	return "SUBSTITUTE", canonical
+}
+
+func resourceRpzRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRpzRuleCreate,
+		ReadContext:   resourceRpzRuleGet,
+		UpdateContext: resourceRpzRuleUpdate,
+		DeleteContext: resourceRpzRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRpzRuleImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			if d.NewValueKnown("trigger") && d.NewValueKnown("rule_type") && d.NewValueKnown("substitute_name") {
+				_, err := rpzCanonicalFromRuleType(
+					d.Get("trigger").(string), d.Get("rule_type").(string), "", d.Get("substitute_name").(string))
+				return err
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the response policy zone does exist within.",
+			},
+			"rp_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The response policy zone, which the rule belongs to.",
+			},
+			"trigger": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "QNAME",
+				ValidateFunc: validation.StringInSlice([]string{"QNAME", "IP_ADDRESS", "CLIENT_IP_ADDRESS"}, false),
+				Description: "The trigger of the rule: 'QNAME' for the domain name of a query, 'IP_ADDRESS' " +
+					"for an IP address in a response, or 'CLIENT_IP_ADDRESS' for the IP address of a client.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "The domain name, the IP address or the network in CIDR format, which triggers the rule, " +
+					"without the response policy zone's name.",
+			},
+			"rule_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"NXDOMAIN", "NODATA", "PASSTHRU", "SUBSTITUTE"}, false),
+				Description: "The policy of the rule: 'NXDOMAIN' or 'NODATA' to block the query, 'PASSTHRU' to let it " +
+					"through, or 'SUBSTITUTE' to redirect it to the substitute name.",
+			},
+			"substitute_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The domain name, which the query is redirected to by a 'SUBSTITUTE' rule.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for the rule.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the rule.",
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether the rule is disabled or not.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the rule to be added/updated, as a map in JSON format.",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+// rpzRuleTriggerFromRef returns the trigger of the rule, by the object type of its reference.
+func rpzRuleTriggerFromRef(ref string) (string, error) {
+	switch strings.SplitN(ref, "/", 2)[0] {
+	case "record:rpz:cname":
+		return "QNAME", nil
+	case "record:rpz:cname:ipaddress":
+		return "IP_ADDRESS", nil
+	case "record:rpz:cname:clientipaddress":
+		return "CLIENT_IP_ADDRESS", nil
+	}
+
+	return "", fmt.Errorf("'%s' is not a reference of an RPZ rule", ref)
+}
+
+// rpzRuleFromResource makes a rule of the resource's fields, except for the DNS view and the extensible attributes.
+func rpzRuleFromResource(d *schema.ResourceData) (*ibclient.RecordRpzCname, error) {
+	rpZone := d.Get("rp_zone").(string)
+	trigger := d.Get("trigger").(string)
+	trigName := d.Get("name").(string)
+	canonical, err := rpzCanonicalFromRuleType(trigger, d.Get("rule_type").(string), trigName, d.Get("substitute_name").(string))
+	if err != nil {
+		return nil, err
+	}
+	name := fmt.Sprintf("%s.%s", trigName, rpZone)
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	ttl, useTtl, err := ttlFromResource(d)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &ibclient.RecordRpzCname{
+		Name:      &name,
+		Canonical: &canonical,
+		RpZone:    &rpZone,
+		Comment:   &comment,
+		Disable:   &disable,
+		Ttl:       &ttl,
+		UseTtl:    &useTtl,
+	}
+
+	return obj, nil
+}
+
+func setRpzRuleFields(d *schema.ResourceData, trigger string, obj *ibclient.RecordRpzCname) error {
+	rpZone := stringPtrValue(obj.RpZone)
+	name := strings.TrimSuffix(stringPtrValue(obj.Name), "."+rpZone)
+	ruleType, substituteName := rpzRuleTypeFromCanonical(name, stringPtrValue(obj.Canonical))
+
+	ttl := ttlUndef
+	if obj.Ttl != nil && obj.UseTtl != nil && *obj.UseTtl {
+		ttl = int(*obj.Ttl)
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+	if err := d.Set("dns_view", stringPtrValue(obj.View)); err != nil {
+		return err
+	}
+	if err := d.Set("rp_zone", rpZone); err != nil {
+		return err
+	}
+	if err := d.Set("trigger", trigger); err != nil {
+		return err
+	}
+	if err := d.Set("name", name); err != nil {
+		return err
+	}
+	if err := d.Set("rule_type", ruleType); err != nil {
+		return err
+	}
+	if err := d.Set("substitute_name", substituteName); err != nil {
+		return err
+	}
+	if err := d.Set("comment", stringPtrValue(obj.Comment)); err != nil {
+		return err
+	}
+
+	return d.Set("disable", obj.Disable != nil && *obj.Disable)
+}
+
+func resourceRpzRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	rule, err := rpzRuleFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	dnsView := d.Get("dns_view").(string)
+	rule.View = &dnsView
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	rule.Ea = extAttrs
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(rpzRuleObject(d.Get("trigger").(string), rule))
+	if err != nil {
+		return diagFromErr(fmt.Errorf("creation of RPZ rule failed: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceRpzRuleGet(ctx, d, m)
+}
+
+func resourceRpzRuleGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	trigger := d.Get("trigger").(string)
+	var obj ibclient.RecordRpzCname
+	if err = getObjectByRefOrInternalId(newEmptyRpzRule(trigger), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setRpzRuleFields(d, trigger, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceRpzRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevRpZone, _ := d.GetChange("rp_zone")
+			prevTrigger, _ := d.GetChange("trigger")
+			prevName, _ := d.GetChange("name")
+			prevRuleType, _ := d.GetChange("rule_type")
+			prevSubstituteName, _ := d.GetChange("substitute_name")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevDisable, _ := d.GetChange("disable")
+			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("rp_zone", prevRpZone.(string))
+			_ = d.Set("trigger", prevTrigger.(string))
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("rule_type", prevRuleType.(string))
+			_ = d.Set("substitute_name", prevSubstituteName.(string))
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("disable", prevDisable.(bool))
+			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+	if d.HasChange("dns_view") {
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
+	}
+	if d.HasChange("rp_zone") {
+		return diagFromErr(fmt.Errorf("changing the value of 'rp_zone' field is not allowed"))
+	}
+	if d.HasChange("trigger") {
+		return diagFromErr(fmt.Errorf("changing the value of 'trigger' field is not allowed"))
+	}
+
+	rule, err := rpzRuleFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	// The zone of a rule is not to be updated.
+	rule.RpZone = nil
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	trigger := d.Get("trigger").(string)
+	var found ibclient.RecordRpzCname
+	if err = getObjectByRefOrInternalId(newEmptyRpzRule(trigger), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	rule.Ea, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := connector.UpdateObject(rpzRuleObject(trigger, rule), found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error updating RPZ rule: %w", err))
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceRpzRuleGet(ctx, d, m)
+}
+
+func resourceRpzRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.RecordRpzCname
+	if err := getObjectByRefOrInternalId(newEmptyRpzRule(d.Get("trigger").(string)), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err := m.(ibclient.IBConnector).DeleteObject(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("deletion of RPZ rule failed: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceRpzRuleImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	trigger, err := rpzRuleTriggerFromRef(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	var obj ibclient.RecordRpzCname
+	err = m.(ibclient.IBConnector).GetObject(newEmptyRpzRule(trigger), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting RPZ rule: %w", err)
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setRpzRuleFields(d, trigger, &obj); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceRpzRuleUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
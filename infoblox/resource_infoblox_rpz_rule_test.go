@@ -0,0 +1,150 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func TestRpzRuleCanonical(t *testing.T) {
+	cases := []struct {
+		trigger, ruleType, substituteName, canonical string
+	}{
+		{"QNAME", "NXDOMAIN", "", ""},
+		{"QNAME", "NODATA", "", "*"},
+		{"QNAME", "PASSTHRU", "", "bad.example.com"},
+		{"QNAME", "SUBSTITUTE", "walled-garden.example.com", "walled-garden.example.com"},
+		{"IP_ADDRESS", "PASSTHRU", "", rpzPassthruCanonical},
+		{"CLIENT_IP_ADDRESS", "NODATA", "", "*"},
+	}
+	for _, c := range cases {
+		canonical, err := rpzCanonicalFromRuleType(c.trigger, c.ruleType, "bad.example.com", c.substituteName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if canonical != c.canonical {
+			t.Fatalf("expected the canonical name '%s' for the %s rule, got '%s'", c.canonical, c.ruleType, canonical)
+		}
+		ruleType, substituteName := rpzRuleTypeFromCanonical("bad.example.com", canonical)
+		if ruleType != c.ruleType || substituteName != c.substituteName {
+			t.Fatalf("expected the %s rule for the canonical name '%s', got %s", c.ruleType, canonical, ruleType)
+		}
+	}
+
+	if _, err := rpzCanonicalFromRuleType("QNAME", "SUBSTITUTE", "bad.example.com", ""); err == nil {
+		t.Fatal("expected an error for the SUBSTITUTE rule without a substitute name")
+	}
+	if _, err := rpzCanonicalFromRuleType("QNAME", "NXDOMAIN", "bad.example.com", "walled-garden.example.com"); err == nil {
+		t.Fatal("expected an error for the substitute name of the NXDOMAIN rule")
+	}
+	if _, err := rpzCanonicalFromRuleType("CLIENT_IP_ADDRESS", "SUBSTITUTE", "10.0.0.1", "walled-garden.example.com"); err == nil {
+		t.Fatal("expected an error for the SUBSTITUTE rule of a client IP address")
+	}
+}
+
+func testAccCheckRpzRuleDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_rpz_rule" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.RecordRpzCname
+		err := connector.GetObject(newEmptyRpzRule(rs.Primary.Attributes["trigger"]), rs.Primary.ID,
+			ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("RPZ rule %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceRpzRule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRpzRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_rp" "rpz1" {
+						fqdn = "rules.rpz.test"
+					}
+					resource "infoblox_rpz_rule" "rule1" {
+						rp_zone   = infoblox_zone_rp.rpz1.fqdn
+						name      = "malware.example.com"
+						rule_type = "NXDOMAIN"
+						comment   = "known malware domain"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}
+					resource "infoblox_rpz_rule" "rule2" {
+						rp_zone   = infoblox_zone_rp.rpz1.fqdn
+						trigger   = "IP_ADDRESS"
+						name      = "192.0.2.0/24"
+						rule_type = "NODATA"
+					}
+					resource "infoblox_rpz_rule" "rule3" {
+						rp_zone   = infoblox_zone_rp.rpz1.fqdn
+						trigger   = "CLIENT_IP_ADDRESS"
+						name      = "10.1.1.1"
+						rule_type = "PASSTHRU"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.rule1", "name", "malware.example.com"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.rule1", "trigger", "QNAME"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.rule1", "rule_type", "NXDOMAIN"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.rule1", "dns_view", "default"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.rule2", "name", "192.0.2.0/24"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.rule2", "rule_type", "NODATA"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.rule3", "rule_type", "PASSTHRU"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_rp" "rpz1" {
+						fqdn = "rules.rpz.test"
+					}
+					resource "infoblox_rpz_rule" "rule1" {
+						rp_zone         = infoblox_zone_rp.rpz1.fqdn
+						name            = "malware.example.com"
+						rule_type       = "SUBSTITUTE"
+						substitute_name = "walled-garden.example.com"
+						ttl             = 300
+					}
+					resource "infoblox_rpz_rule" "rule2" {
+						rp_zone   = infoblox_zone_rp.rpz1.fqdn
+						trigger   = "IP_ADDRESS"
+						name      = "192.0.2.0/24"
+						rule_type = "PASSTHRU"
+						disable   = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.rule1", "rule_type", "SUBSTITUTE"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.rule1", "substitute_name", "walled-garden.example.com"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.rule1", "ttl", "300"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.rule1", "comment", ""),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.rule2", "rule_type", "PASSTHRU"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.rule2", "disable", "true"),
+				),
+			},
+			{
+				ResourceName:            "infoblox_rpz_rule.rule1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+			{
+				ResourceName:            "infoblox_rpz_rule.rule2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
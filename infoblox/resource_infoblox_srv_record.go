@@ -4,20 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func resourceSRVRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSRVRecordCreate,
-		Read:   resourceSRVRecordGet,
-		Update: resourceSRVRecordUpdate,
-		Delete: resourceSRVRecordDelete,
+		CreateContext: resourceSRVRecordCreate,
+		ReadContext:   resourceSRVRecordGet,
+		UpdateContext: resourceSRVRecordUpdate,
+		DeleteContext: resourceSRVRecordDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceSRVRecordImport,
+			StateContext: resourceSRVRecordImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -78,6 +80,7 @@ func resourceSRVRecord() *schema.Resource {
 				Default:     "",
 				Description: "Extensible attributes of the SRV-record to be added/updated, as a map in JSON format.",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -94,10 +97,10 @@ func resourceSRVRecord() *schema.Resource {
 	}
 }
 
-func resourceSRVRecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourceSRVRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	dnsView := d.Get("dns_view").(string)
@@ -117,17 +120,18 @@ func resourceSRVRecordCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	// Generate internal ID and add it to the extensible attributes
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -144,34 +148,33 @@ func resourceSRVRecordCreate(d *schema.ResourceData, m interface{}) error {
 		dnsView, name, uint32(priority), uint32(weight), uint32(port), target, ttl, useTtl, comment, extAttrs)
 
 	if err != nil {
-		return fmt.Errorf("error creating SRV-record: %s", err.Error())
+		return diagFromErr(fmt.Errorf("error creating SRV-record: %w", err))
 	}
 
 	d.SetId(newRecord.Ref)
 
 	if err = d.Set("ref", newRecord.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func resourceSRVRecordGet(d *schema.ResourceData, m interface{}) error {
+func resourceSRVRecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rec, err := searchObjectByRefOrInternalId("SRV", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -184,7 +187,7 @@ func resourceSRVRecordGet(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &obj)
 
 	if err != nil && obj.Ref != "" {
-		return fmt.Errorf("failed getting SRV-Record: %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting SRV-Record: %w", err))
 	}
 
 	if obj.Ttl != nil {
@@ -195,52 +198,52 @@ func resourceSRVRecordGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	delete(obj.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("dns_view", obj.View); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("name", obj.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("priority", obj.Priority); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("weight", obj.Weight); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("port", obj.Port); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("target", obj.Target); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(obj.Ref)
 
 	return nil
 }
 
-func resourceSRVRecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceSRVRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -257,6 +260,7 @@ func resourceSRVRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("dns_view", prevDNSView.(string))
 			_ = d.Set("name", prevName.(string))
@@ -267,15 +271,16 @@ func resourceSRVRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 		}
 	}()
 
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 
 	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
 	}
 
 	// the next group of parameters will be validated inside ibclient.UpdateSRVRecord()
@@ -293,21 +298,14 @@ func resourceSRVRecordUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -320,7 +318,7 @@ func resourceSRVRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	srvrec, err := objMgr.GetSRVRecordByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read SRV Record for update operation: %w", err)
+		return diagFromErr(fmt.Errorf("failed to read SRV Record for update operation: %w", err))
 	}
 
 	internalId := d.Get("internal_id").(string)
@@ -334,31 +332,30 @@ func resourceSRVRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(srvrec.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rec, err := objMgr.UpdateSRVRecord(
 		d.Id(), name, uint32(priority), uint32(weight), uint32(port), target, ttl, useTtl, comment, newExtAttrs)
 	if err != nil {
-		return fmt.Errorf("error updating SRV-Record: %s", err.Error())
+		return diagFromErr(fmt.Errorf("error updating SRV-Record: %w", err))
 	}
 	updateSuccessful = true
 	d.SetId(rec.Ref)
 	if err = d.Set("ref", rec.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func resourceSRVRecordDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceSRVRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -372,8 +369,8 @@ func resourceSRVRecordDelete(d *schema.ResourceData, m interface{}) error {
 	srvrec, err := searchObjectByRefOrInternalId("SRV", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -386,12 +383,12 @@ func resourceSRVRecordDelete(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &obj)
 
 	if err != nil {
-		return fmt.Errorf("failed getting SRV-Record: %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting SRV-Record: %w", err))
 	}
 
 	_, err = objMgr.DeleteSRVRecord(obj.Ref)
 	if err != nil {
-		return fmt.Errorf("deletion of MX-Record failed: %s", err.Error())
+		return diagFromErr(fmt.Errorf("deletion of MX-Record failed: %w", err))
 	}
 	d.SetId("")
 
@@ -399,10 +396,9 @@ func resourceSRVRecordDelete(d *schema.ResourceData, m interface{}) error {
 
 }
 
-func resourceSRVRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceSRVRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -417,7 +413,7 @@ func resourceSRVRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 
 	obj, err := objMgr.GetSRVRecordByRef(d.Id())
 	if err != nil {
-		return nil, fmt.Errorf("failed getting SRV-Record: %s", err.Error())
+		return nil, fmt.Errorf("failed getting SRV-Record: %w", err)
 	}
 
 	if obj.Ttl != nil {
@@ -437,11 +433,11 @@ func resourceSRVRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 	}
 
 	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
 		if err != nil {
 			return nil, err
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -469,9 +465,8 @@ func resourceSRVRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 	}
 	d.SetId(obj.Ref)
 
-	err = resourceSRVRecordUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceSRVRecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 
 	return []*schema.ResourceData{d}, nil
@@ -0,0 +1,142 @@
+package infoblox
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func TestSvcParamsFromResource(t *testing.T) {
+	block := map[string]interface{}{
+		"alpn":      []interface{}{"h2", "h3"},
+		"port":      8443,
+		"ipv4hint":  []interface{}{"192.0.2.1"},
+		"ipv6hint":  []interface{}{"2001:db8::1"},
+		"ech":       "",
+		"mandatory": schema.NewSet(schema.HashString, []interface{}{"port", "alpn"}),
+	}
+	params, err := svcParamsFromResource(1, []interface{}{block})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []ibclient.SVCParams{
+		{SvcKey: "alpn", SvcValue: []string{"h2", "h3"}, Mandatory: true},
+		{SvcKey: "port", SvcValue: []string{"8443"}, Mandatory: true},
+		{SvcKey: "ipv4hint", SvcValue: []string{"192.0.2.1"}},
+		{SvcKey: "ipv6hint", SvcValue: []string{"2001:db8::1"}},
+	}
+	if !reflect.DeepEqual(params, expected) {
+		t.Fatalf("unexpected service parameters: %+v", params)
+	}
+
+	converted := convertSvcParamsToInterface(params)
+	if len(converted) != 1 {
+		t.Fatalf("unexpected 'svc_params' block: %v", converted)
+	}
+	if back := converted[0].(map[string]interface{}); back["port"] != 8443 ||
+		!reflect.DeepEqual(back["mandatory"], []interface{}{"alpn", "port"}) {
+		t.Fatalf("unexpected 'svc_params' block: %v", back)
+	}
+
+	if _, err = svcParamsFromResource(0, []interface{}{block}); err == nil {
+		t.Fatal("expected an error for the AliasMode record")
+	}
+
+	block["mandatory"] = schema.NewSet(schema.HashString, []interface{}{"ech"})
+	if _, err = svcParamsFromResource(1, []interface{}{block}); err == nil {
+		t.Fatal("expected an error for the mandatory parameter, which is not set")
+	}
+
+	if _, err = svcParamsFromResource(1, []interface{}{nil}); err == nil {
+		t.Fatal("expected an error for the empty block")
+	}
+}
+
+func testAccCheckSVCBRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_svcb_record" {
+			continue
+		}
+		objMgr := ibclient.NewObjectManager(meta.(ibclient.IBConnector), "terraform_test", "test")
+		if _, err := objMgr.GetSVCBRecordByRef(rs.Primary.ID); err == nil {
+			return fmt.Errorf("SVCB-record %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceSVCBRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSVCBRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "svcb.test.com"
+					}
+					resource "infoblox_svcb_record" "rec1" {
+						name        = "_dns.svcb.test.com"
+						priority    = 1
+						target_name = "dns.svcb.test.com"
+						svc_params {
+							alpn      = ["dot"]
+							port      = 853
+							ipv4hint  = ["192.0.2.53"]
+							mandatory = ["port", "alpn"]
+						}
+						ttl     = 300
+						comment = "DNS over TLS"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+						depends_on = [infoblox_zone_auth.zone1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_svcb_record.rec1", "priority", "1"),
+					resource.TestCheckResourceAttr("infoblox_svcb_record.rec1", "zone", "svcb.test.com"),
+					resource.TestCheckResourceAttr("infoblox_svcb_record.rec1", "svc_params.0.alpn.0", "dot"),
+					resource.TestCheckResourceAttr("infoblox_svcb_record.rec1", "svc_params.0.port", "853"),
+					resource.TestCheckResourceAttr("infoblox_svcb_record.rec1", "svc_params.0.ipv4hint.0", "192.0.2.53"),
+					resource.TestCheckResourceAttr("infoblox_svcb_record.rec1", "svc_params.0.mandatory.#", "2"),
+					resource.TestCheckTypeSetElemAttr("infoblox_svcb_record.rec1", "svc_params.0.mandatory.*", "port"),
+					resource.TestCheckTypeSetElemAttr("infoblox_svcb_record.rec1", "svc_params.0.mandatory.*", "alpn"),
+					resource.TestCheckResourceAttr("infoblox_svcb_record.rec1", "ttl", "300"),
+					resource.TestCheckResourceAttr("infoblox_svcb_record.rec1", "ext_attrs", `{"Site":"HQ"}`),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "svcb.test.com"
+					}
+					resource "infoblox_svcb_record" "rec1" {
+						name        = "_dns.svcb.test.com"
+						priority    = 0
+						target_name = "resolver.svcb.test.com"
+						depends_on  = [infoblox_zone_auth.zone1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_svcb_record.rec1", "priority", "0"),
+					resource.TestCheckResourceAttr("infoblox_svcb_record.rec1", "target_name", "resolver.svcb.test.com"),
+					resource.TestCheckResourceAttr("infoblox_svcb_record.rec1", "svc_params.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_svcb_record.rec1", "ttl", "-1"),
+				),
+			},
+			{
+				ResourceName:            "infoblox_svcb_record.rec1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
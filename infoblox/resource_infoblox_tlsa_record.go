@@ -0,0 +1,446 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var tlsaRecordReturnFields = []string{
+	"certificate_data", "certificate_usage", "comment", "disable", "extattrs", "matched_type", "name", "selector",
+	"ttl", "use_ttl", "view", "zone",
+}
+
+func newEmptyTlsaRecord() *ibclient.RecordTlsa {
+	obj := &ibclient.RecordTlsa{}
+	obj.SetReturnFields(tlsaRecordReturnFields)
+
+	return obj
+}
+
+// tlsaNameRegexp matches the owner name of a TLSA-record: _port._protocol.host
+var tlsaNameRegexp = regexp.MustCompile(`^_[0-9]{1,5}\._(tcp|udp|sctp)\..+`)
+
+// tlsaDigestLengths are the lengths, in bytes, of the certificate data for the matched types, which are digests.
+var tlsaDigestLengths = map[int]int{
+	1: 32, // SHA-256
+	2: 64, // SHA-512
+}
+
+// validateTlsaCertificateData checks the certificate data of a TLSA-record against its matched type.
+func validateTlsaCertificateData(matchedType int, certificateData string) error {
+	data, err := hex.DecodeString(certificateData)
+	if err != nil {
+		return fmt.Errorf("'certificate_data' must be a hexadecimal string: %w", err)
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("'certificate_data' must not be empty")
+	}
+	if l, found := tlsaDigestLengths[matchedType]; found && len(data) != l {
+		return fmt.Errorf("'certificate_data' must be %d bytes long for the matched type %d, got %d", l, matchedType, len(data))
+	}
+
+	return nil
+}
+
+func resourceTLSARecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTLSARecordCreate,
+		ReadContext:   resourceTLSARecordGet,
+		UpdateContext: resourceTLSARecordUpdate,
+		DeleteContext: resourceTLSARecordDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTLSARecordImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			if d.NewValueKnown("matched_type") && d.NewValueKnown("certificate_data") {
+				if err := validateTlsaCertificateData(d.Get("matched_type").(int), d.Get("certificate_data").(string)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(tlsaNameRegexp, "must be in the '_port._protocol.host' format"),
+				Description:  "The owner name of the TLSA-record, in the '_port._protocol.host' format, ex. '_25._tcp.mail.example.com'.",
+			},
+			"certificate_usage": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 3),
+				Description:  "The usage of the certificate: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE).",
+			},
+			"selector": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 1),
+				Description:  "The part of the certificate to match: 0 for the full certificate, 1 for the public key.",
+			},
+			"matched_type": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 2),
+				Description:  "The presentation of the certificate data: 0 for the exact match, 1 for SHA-256 and 2 for SHA-512 hash.",
+			},
+			"certificate_data": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The certificate data to match, hex-encoded.",
+				StateFunc: func(val interface{}) string {
+					return strings.ToUpper(val.(string))
+				},
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether the TLSA-record is disabled or not.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for the TLSA-record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the TLSA-record.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the TLSA-record to be added/updated, as a map in JSON format.",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+// tlsaRecordFromResource makes a TLSA-record of the resource's fields, except for the DNS view and the extensible attributes.
+func tlsaRecordFromResource(d *schema.ResourceData) (*ibclient.RecordTlsa, error) {
+	name := d.Get("name").(string)
+	certificateUsage := uint32(d.Get("certificate_usage").(int))
+	selector := uint32(d.Get("selector").(int))
+	matchedType := d.Get("matched_type").(int)
+	certificateData := strings.ToUpper(d.Get("certificate_data").(string))
+	if err := validateTlsaCertificateData(matchedType, certificateData); err != nil {
+		return nil, err
+	}
+	matchedTypeValue := uint32(matchedType)
+	disable := d.Get("disable").(bool)
+	comment := d.Get("comment").(string)
+
+	ttl, useTtl, err := ttlFromResource(d)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &ibclient.RecordTlsa{
+		Name:             &name,
+		CertificateUsage: &certificateUsage,
+		Selector:         &selector,
+		MatchedType:      &matchedTypeValue,
+		CertificateData:  &certificateData,
+		Disable:          &disable,
+		Comment:          &comment,
+		Ttl:              &ttl,
+		UseTtl:           &useTtl,
+	}
+
+	return obj, nil
+}
+
+func setTLSARecordFields(d *schema.ResourceData, obj *ibclient.RecordTlsa) error {
+	ttl := ttlUndef
+	if obj.Ttl != nil && obj.UseTtl != nil && *obj.UseTtl {
+		ttl = int(*obj.Ttl)
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+	if err := d.Set("certificate_usage", uint32PtrValue(obj.CertificateUsage)); err != nil {
+		return err
+	}
+	if err := d.Set("selector", uint32PtrValue(obj.Selector)); err != nil {
+		return err
+	}
+	if err := d.Set("matched_type", uint32PtrValue(obj.MatchedType)); err != nil {
+		return err
+	}
+	if err := d.Set("certificate_data", stringPtrValue(obj.CertificateData)); err != nil {
+		return err
+	}
+	if err := d.Set("disable", obj.Disable != nil && *obj.Disable); err != nil {
+		return err
+	}
+	if err := d.Set("comment", stringPtrValue(obj.Comment)); err != nil {
+		return err
+	}
+	if err := d.Set("dns_view", stringPtrValue(obj.View)); err != nil {
+		return err
+	}
+
+	return d.Set("name", stringPtrValue(obj.Name))
+}
+
+func resourceTLSARecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	obj, err := tlsaRecordFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	dnsView := d.Get("dns_view").(string)
+	obj.View = &dnsView
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	obj.Ea = extAttrs
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(obj)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("creation of TLSA-record failed: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceTLSARecordGet(ctx, d, m)
+}
+
+func resourceTLSARecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var obj ibclient.RecordTlsa
+	if err = getObjectByRefOrInternalId(newEmptyTlsaRecord(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setTLSARecordFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceTLSARecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevName, _ := d.GetChange("name")
+			prevCertificateUsage, _ := d.GetChange("certificate_usage")
+			prevSelector, _ := d.GetChange("selector")
+			prevMatchedType, _ := d.GetChange("matched_type")
+			prevCertificateData, _ := d.GetChange("certificate_data")
+			prevDisable, _ := d.GetChange("disable")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("certificate_usage", prevCertificateUsage.(int))
+			_ = d.Set("selector", prevSelector.(int))
+			_ = d.Set("matched_type", prevMatchedType.(int))
+			_ = d.Set("certificate_data", prevCertificateData.(string))
+			_ = d.Set("disable", prevDisable.(bool))
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+	if d.HasChange("dns_view") {
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
+	}
+
+	obj, err := tlsaRecordFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var found ibclient.RecordTlsa
+	if err = getObjectByRefOrInternalId(newEmptyTlsaRecord(), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	obj.Ea, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := connector.UpdateObject(obj, found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error updating TLSA-record: %w", err))
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceTLSARecordGet(ctx, d, m)
+}
+
+func resourceTLSARecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.RecordTlsa
+	if err := getObjectByRefOrInternalId(newEmptyTlsaRecord(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err := m.(ibclient.IBConnector).DeleteObject(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("deletion of TLSA-record failed: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceTLSARecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	var obj ibclient.RecordTlsa
+	err := m.(ibclient.IBConnector).GetObject(newEmptyTlsaRecord(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting TLSA-record: %w", err)
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setTLSARecordFields(d, &obj); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceTLSARecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,140 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func TestValidateTlsaCertificateData(t *testing.T) {
+	if err := validateTlsaCertificateData(1, "0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6"); err != nil {
+		t.Fatal(err)
+	}
+	if err := validateTlsaCertificateData(0, "3082010a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := validateTlsaCertificateData(2, "0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6"); err == nil {
+		t.Fatal("expected an error for the SHA-256 digest with the SHA-512 matched type")
+	}
+	if err := validateTlsaCertificateData(0, "not a hex string"); err == nil {
+		t.Fatal("expected an error for the non-hexadecimal data")
+	}
+	if err := validateTlsaCertificateData(0, ""); err == nil {
+		t.Fatal("expected an error for the empty data")
+	}
+}
+
+func testAccCheckTLSARecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_tlsa_record" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.RecordTlsa
+		err := connector.GetObject(newEmptyTlsaRecord(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("TLSA-record %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceTLSARecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTLSARecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "tlsa.test.com"
+					}
+					resource "infoblox_tlsa_record" "rec1" {
+						name              = "_25._tcp.mail.tlsa.test.com"
+						certificate_usage = 3
+						selector          = 1
+						matched_type      = 1
+						certificate_data  = "0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6"
+						comment           = "DANE for SMTP"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+						depends_on = [infoblox_zone_auth.zone1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.rec1", "certificate_usage", "3"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.rec1", "selector", "1"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.rec1", "matched_type", "1"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.rec1", "certificate_data", "0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.rec1", "disable", "false"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "tlsa.test.com"
+					}
+					resource "infoblox_tlsa_record" "rec1" {
+						name              = "_25._tcp.mail.tlsa.test.com"
+						certificate_usage = 2
+						selector          = 0
+						matched_type      = 1
+						certificate_data  = "0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6"
+						ttl               = 600
+						disable           = true
+						depends_on        = [infoblox_zone_auth.zone1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.rec1", "certificate_usage", "2"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.rec1", "selector", "0"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.rec1", "ttl", "600"),
+					resource.TestCheckResourceAttr("infoblox_tlsa_record.rec1", "disable", "true"),
+				),
+			},
+			{
+				ResourceName:            "infoblox_tlsa_record.rec1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
+
+func TestAccResourceTLSARecordValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_tlsa_record" "rec1" {
+						name              = "mail.tlsa.test.com"
+						certificate_usage = 3
+						selector          = 1
+						matched_type      = 1
+						certificate_data  = "0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6"
+					}`,
+				ExpectError: regexp.MustCompile("_port._protocol.host"),
+			},
+			{
+				Config: `
+					resource "infoblox_tlsa_record" "rec1" {
+						name              = "_443._tcp.www.tlsa.test.com"
+						certificate_usage = 3
+						selector          = 1
+						matched_type      = 2
+						certificate_data  = "0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6"
+					}`,
+				ExpectError: regexp.MustCompile("must be 64 bytes long"),
+			},
+		},
+	})
+}
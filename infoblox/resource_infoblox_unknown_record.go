@@ -0,0 +1,554 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var unknownRecordReturnFields = []string{
+	"comment", "disable", "display_rdata", "extattrs", "name", "record_type", "subfield_values", "ttl", "use_ttl",
+	"view", "zone",
+}
+
+func newEmptyUnknownRecord() *ibclient.RecordUnknown {
+	obj := &ibclient.RecordUnknown{}
+	obj.SetReturnFields(unknownRecordReturnFields)
+
+	return obj
+}
+
+var (
+	// rrTypeRegexp matches an RR type mnemonic, ex. 'SSHFP', or the generic 'TYPEnnn' notation of RFC 3597.
+	rrTypeRegexp     = regexp.MustCompile(`^([A-Z][A-Z0-9-]{0,15}|TYPE[0-9]{1,5})$`)
+	domainNameRegexp = regexp.MustCompile(`^(\.|([a-zA-Z0-9_]([a-zA-Z0-9_-]*[a-zA-Z0-9_])?\.)*[a-zA-Z0-9_]([a-zA-Z0-9_-]*[a-zA-Z0-9_])?\.?)$`)
+)
+
+// dedicatedRecordResources are the RR types, which are managed by the dedicated resources rather than as unknown records.
+var dedicatedRecordResources = map[string]string{
+	"A":     "infoblox_a_record",
+	"AAAA":  "infoblox_aaaa_record",
+	"CAA":   "infoblox_caa_record",
+	"CNAME": "infoblox_cname_record",
+	"DNAME": "infoblox_dname_record",
+	"HTTPS": "infoblox_https_record",
+	"MX":    "infoblox_mx_record",
+	"NAPTR": "infoblox_naptr_record",
+	"NS":    "infoblox_ns_record",
+	"PTR":   "infoblox_ptr_record",
+	"SOA":   "infoblox_zone_auth",
+	"SRV":   "infoblox_srv_record",
+	"SVCB":  "infoblox_svcb_record",
+	"TLSA":  "infoblox_tlsa_record",
+	"TXT":   "infoblox_txt_record",
+}
+
+// validateRecordType checks the type of an unknown record.
+func validateRecordType(val interface{}, key string) (warnings []string, errors []error) {
+	recordType := val.(string)
+	if !rrTypeRegexp.MatchString(recordType) {
+		return nil, []error{fmt.Errorf("%q must be an RR type name in upper case, ex. 'SSHFP', or 'TYPEnnn', got '%s'", key, recordType)}
+	}
+	if num := strings.TrimPrefix(recordType, "TYPE"); num != recordType {
+		if n, err := strconv.Atoi(num); err != nil || n > 65535 {
+			return nil, []error{fmt.Errorf("%q must be a type number in the range 0..65535, got '%s'", key, recordType)}
+		}
+	}
+	if resourceName, found := dedicatedRecordResources[recordType]; found {
+		return nil, []error{fmt.Errorf("%q: '%s' records are managed by the '%s' resource", key, recordType, resourceName)}
+	}
+
+	return nil, nil
+}
+
+// validateRdataSubfield checks the value of an RDATA subfield against its type.
+func validateRdataSubfield(fieldType, value string) error {
+	var err error
+	switch fieldType {
+	case "B", "S", "I":
+		bits := map[string]int{"B": 8, "S": 16, "I": 32}[fieldType]
+		_, err = strconv.ParseUint(value, 10, bits)
+	case "H":
+		_, err = base64.StdEncoding.DecodeString(value)
+	case "X":
+		_, err = hex.DecodeString(value)
+	case "4":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+			err = fmt.Errorf("not an IPv4 address")
+		}
+	case "6":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() != nil {
+			err = fmt.Errorf("not an IPv6 address")
+		}
+	case "N":
+		if !domainNameRegexp.MatchString(value) {
+			err = fmt.Errorf("not a domain name")
+		}
+	case "T":
+	default:
+		return fmt.Errorf("unsupported subfield type '%s'", fieldType)
+	}
+	if err != nil {
+		return fmt.Errorf("'%s' is not a valid value for the subfield type '%s': %w", value, fieldType, err)
+	}
+
+	return nil
+}
+
+func resourceUnknownRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUnknownRecordCreate,
+		ReadContext:   resourceUnknownRecordGet,
+		UpdateContext: resourceUnknownRecordUpdate,
+		DeleteContext: resourceUnknownRecordDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUnknownRecordImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			if d.NewValueKnown("subfield_values") {
+				if _, err := convertInterfaceToRdataSubfields(d.Get("subfield_values").([]interface{})); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the record, in FQDN format.",
+			},
+			"record_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRecordType,
+				Description:  "The RR type of the record, ex. 'SSHFP', 'URI' or 'TYPE65280'.",
+			},
+			"subfield_values": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The RDATA subfields of the record, in the order they appear in the RDATA.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"B", "S", "I", "H", "6", "4", "N", "T", "X"}, false),
+							Description: "The type of the subfield: 'B', 'S' or 'I' for 8, 16 or 32-bit unsigned integer, " +
+								"'H' for BASE64, 'X' for hex-encoded opaque binary data, '4' for an IPv4 address, " +
+								"'6' for an IPv6 address, 'N' for a domain name and 'T' for a text string.",
+						},
+						"field_value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value of the subfield.",
+						},
+						"include_length": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "NONE",
+							ValidateFunc: validation.StringInSlice([]string{"NONE", "8_BIT", "16_BIT"}, false),
+							Description:  "The size of the length prefix of the subfield in the RDATA: 'NONE', '8_BIT' or '16_BIT'.",
+						},
+					},
+				},
+			},
+			"display_rdata": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Standard textual representation of the RDATA.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for the record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the record.",
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether the record is disabled or not.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone which the record belongs to.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the record to be added/updated, as a map in JSON format.",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+func convertInterfaceToRdataSubfields(subfields []interface{}) ([]*ibclient.Rdatasubfield, error) {
+	res := make([]*ibclient.Rdatasubfield, 0, len(subfields))
+	for i, sf := range subfields {
+		subfield, ok := sf.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("subfield #%d: 'field_type' and 'field_value' are required", i+1)
+		}
+		fieldType := subfield["field_type"].(string)
+		fieldValue := subfield["field_value"].(string)
+		if err := validateRdataSubfield(fieldType, fieldValue); err != nil {
+			return nil, fmt.Errorf("subfield #%d: %w", i+1, err)
+		}
+		res = append(res, &ibclient.Rdatasubfield{
+			FieldType:     fieldType,
+			FieldValue:    fieldValue,
+			IncludeLength: subfield["include_length"].(string),
+		})
+	}
+
+	return res, nil
+}
+
+func convertRdataSubfieldsToInterface(subfields []*ibclient.Rdatasubfield) []interface{} {
+	res := make([]interface{}, 0, len(subfields))
+	for _, sf := range subfields {
+		includeLength := sf.IncludeLength
+		if includeLength == "" {
+			includeLength = "NONE"
+		}
+		res = append(res, map[string]interface{}{
+			"field_type":     sf.FieldType,
+			"field_value":    sf.FieldValue,
+			"include_length": includeLength,
+		})
+	}
+
+	return res
+}
+
+// unknownRecordFromResource makes an unknown record of the resource's fields,
+// except for the DNS view, the record type and the extensible attributes.
+func unknownRecordFromResource(d *schema.ResourceData) (*ibclient.RecordUnknown, error) {
+	name := d.Get("name").(string)
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+
+	subfields, err := convertInterfaceToRdataSubfields(d.Get("subfield_values").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	ttl, useTtl, err := ttlFromResource(d)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &ibclient.RecordUnknown{
+		Name:           &name,
+		SubfieldValues: subfields,
+		Comment:        &comment,
+		Disable:        &disable,
+		Ttl:            &ttl,
+		UseTtl:         &useTtl,
+	}
+
+	return obj, nil
+}
+
+func setUnknownRecordFields(d *schema.ResourceData, obj *ibclient.RecordUnknown) error {
+	ttl := ttlUndef
+	if obj.Ttl != nil && obj.UseTtl != nil && *obj.UseTtl {
+		ttl = int(*obj.Ttl)
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+	if err := d.Set("record_type", stringPtrValue(obj.RecordType)); err != nil {
+		return err
+	}
+	if err := d.Set("subfield_values", convertRdataSubfieldsToInterface(obj.SubfieldValues)); err != nil {
+		return err
+	}
+	if err := d.Set("display_rdata", obj.DisplayRdata); err != nil {
+		return err
+	}
+	if err := d.Set("comment", stringPtrValue(obj.Comment)); err != nil {
+		return err
+	}
+	if err := d.Set("disable", obj.Disable != nil && *obj.Disable); err != nil {
+		return err
+	}
+	if err := d.Set("zone", obj.Zone); err != nil {
+		return err
+	}
+	if err := d.Set("dns_view", stringPtrValue(obj.View)); err != nil {
+		return err
+	}
+
+	return d.Set("name", stringPtrValue(obj.Name))
+}
+
+func resourceUnknownRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	obj, err := unknownRecordFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	dnsView := d.Get("dns_view").(string)
+	obj.View = &dnsView
+	recordType := d.Get("record_type").(string)
+	obj.RecordType = &recordType
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	obj.Ea = extAttrs
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(obj)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("creation of %s record failed: %w", recordType, err))
+	}
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceUnknownRecordGet(ctx, d, m)
+}
+
+func resourceUnknownRecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var obj ibclient.RecordUnknown
+	if err = getObjectByRefOrInternalId(newEmptyUnknownRecord(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setUnknownRecordFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceUnknownRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevName, _ := d.GetChange("name")
+			prevRecordType, _ := d.GetChange("record_type")
+			prevSubfieldValues, _ := d.GetChange("subfield_values")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevDisable, _ := d.GetChange("disable")
+			prevEa, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("record_type", prevRecordType.(string))
+			_ = d.Set("subfield_values", prevSubfieldValues)
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("disable", prevDisable.(bool))
+			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+	if d.HasChange("dns_view") {
+		return diagFromErr(fmt.Errorf("changing the value of 'dns_view' field is not allowed"))
+	}
+	if d.HasChange("record_type") {
+		return diagFromErr(fmt.Errorf("changing the value of 'record_type' field is not allowed"))
+	}
+
+	obj, err := unknownRecordFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var found ibclient.RecordUnknown
+	if err = getObjectByRefOrInternalId(newEmptyUnknownRecord(), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	obj.Ea, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := connector.UpdateObject(obj, found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error updating %s record: %w", d.Get("record_type").(string), err))
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceUnknownRecordGet(ctx, d, m)
+}
+
+func resourceUnknownRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.RecordUnknown
+	if err := getObjectByRefOrInternalId(newEmptyUnknownRecord(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err := m.(ibclient.IBConnector).DeleteObject(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("deletion of %s record failed: %w", stringPtrValue(obj.RecordType), err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceUnknownRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	var obj ibclient.RecordUnknown
+	err := m.(ibclient.IBConnector).GetObject(newEmptyUnknownRecord(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting unknown record: %w", err)
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setUnknownRecordFields(d, &obj); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceUnknownRecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
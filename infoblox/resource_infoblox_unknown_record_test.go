@@ -0,0 +1,177 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func TestValidateRecordType(t *testing.T) {
+	for _, recordType := range []string{"SSHFP", "OPENPGPKEY", "URI", "HINFO", "NSEC3PARAM", "TYPE65280"} {
+		if _, errs := validateRecordType(recordType, "record_type"); len(errs) != 0 {
+			t.Fatalf("unexpected error for '%s': %s", recordType, errs[0])
+		}
+	}
+	for _, recordType := range []string{"", "sshfp", "1SSHFP", "TYPE65536", "TYPE", "A", "CNAME", "TXT"} {
+		if _, errs := validateRecordType(recordType, "record_type"); len(errs) == 0 {
+			t.Fatalf("expected an error for '%s'", recordType)
+		}
+	}
+}
+
+func TestValidateRdataSubfield(t *testing.T) {
+	valid := [][2]string{
+		{"B", "255"}, {"S", "65535"}, {"I", "4294967295"}, {"H", "dGVzdA=="}, {"X", "0a1B2c"},
+		{"4", "10.0.0.1"}, {"6", "2001:db8::1"}, {"N", "host.example.com."}, {"T", "any text"},
+	}
+	for _, sf := range valid {
+		if err := validateRdataSubfield(sf[0], sf[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	invalid := [][2]string{
+		{"B", "256"}, {"S", "-1"}, {"I", "4294967296"}, {"H", "not base64"}, {"X", "0a1"},
+		{"4", "2001:db8::1"}, {"6", "10.0.0.1"}, {"N", "bad..name"}, {"Z", "1"},
+	}
+	for _, sf := range invalid {
+		if err := validateRdataSubfield(sf[0], sf[1]); err == nil {
+			t.Fatalf("expected an error for the value '%s' of the type '%s'", sf[1], sf[0])
+		}
+	}
+}
+
+func testAccCheckUnknownRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_unknown_record" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.RecordUnknown
+		err := connector.GetObject(newEmptyUnknownRecord(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("unknown record %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceUnknownRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUnknownRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "unknown.test.com"
+					}
+					resource "infoblox_unknown_record" "rec1" {
+						name        = "host1.unknown.test.com"
+						record_type = "SSHFP"
+						subfield_values {
+							field_type  = "B"
+							field_value = "4"
+						}
+						subfield_values {
+							field_type  = "B"
+							field_value = "2"
+						}
+						subfield_values {
+							field_type  = "X"
+							field_value = "123456789ABCDEF67890123456789ABCDEF67890123456789ABCDEF123456789"
+						}
+						comment = "SSH host key fingerprint"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+						depends_on = [infoblox_zone_auth.zone1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_unknown_record.rec1", "record_type", "SSHFP"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.rec1", "subfield_values.#", "3"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.rec1", "subfield_values.0.field_value", "4"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.rec1", "subfield_values.2.field_type", "X"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.rec1", "zone", "unknown.test.com"),
+					resource.TestCheckResourceAttrSet("infoblox_unknown_record.rec1", "display_rdata"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "unknown.test.com"
+					}
+					resource "infoblox_unknown_record" "rec1" {
+						name        = "host1.unknown.test.com"
+						record_type = "SSHFP"
+						subfield_values {
+							field_type  = "B"
+							field_value = "1"
+						}
+						subfield_values {
+							field_type  = "B"
+							field_value = "1"
+						}
+						subfield_values {
+							field_type  = "X"
+							field_value = "123456789ABCDEF67890123456789ABCDEF67890"
+						}
+						ttl        = 600
+						disable    = true
+						depends_on = [infoblox_zone_auth.zone1]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_unknown_record.rec1", "subfield_values.0.field_value", "1"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.rec1", "ttl", "600"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.rec1", "disable", "true"),
+					resource.TestCheckResourceAttr("infoblox_unknown_record.rec1", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "infoblox_unknown_record.rec1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
+
+func TestAccResourceUnknownRecordValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_unknown_record" "rec1" {
+						name        = "host1.unknown.test.com"
+						record_type = "TXT"
+						subfield_values {
+							field_type  = "T"
+							field_value = "some text"
+						}
+					}`,
+				ExpectError: regexp.MustCompile("infoblox_txt_record"),
+			},
+			{
+				Config: `
+					resource "infoblox_unknown_record" "rec1" {
+						name        = "host1.unknown.test.com"
+						record_type = "SSHFP"
+						subfield_values {
+							field_type  = "B"
+							field_value = "300"
+						}
+					}`,
+				ExpectError: regexp.MustCompile("not a valid value"),
+			},
+		},
+	})
+}
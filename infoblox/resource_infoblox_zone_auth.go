@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/infobloxopen/infoblox-go-client/v2/utils"
@@ -11,6 +13,48 @@ import (
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
+var zoneAuthReturnFields = []string{
+	"allow_active_dir", "allow_query", "allow_transfer", "allow_update", "comment", "extattrs", "external_primaries",
+	"external_secondaries", "fqdn", "grid_primary", "grid_secondaries", "ns_group", "soa_default_ttl", "soa_expire",
+	"soa_negative_ttl", "soa_refresh", "soa_retry", "use_allow_active_dir", "use_allow_query", "use_allow_transfer",
+	"use_allow_update", "view", "zone_format",
+}
+
+// zoneAuthServerFields are the fields of the name servers, which serve the zone instead of a name server group.
+var zoneAuthServerFields = []string{"grid_primary", "grid_secondaries", "external_primaries", "external_secondaries"}
+
+// zoneAuthObject is the generated ZoneAuth, which sends the lists of the name servers even if they are empty,
+// so that the name servers may be removed on update; the lists are omitted when not set.
+// The access control lists consist of either the access control entries or a named ACL's reference.
+type zoneAuthObject struct {
+	ibclient.ZoneAuth
+	ExternalPrimaries   *[]ibclient.NameServer    `json:"external_primaries,omitempty"`
+	ExternalSecondaries *[]ibclient.NameServer    `json:"external_secondaries,omitempty"`
+	GridPrimary         *[]*ibclient.Memberserver `json:"grid_primary,omitempty"`
+	GridSecondaries     *[]*ibclient.Memberserver `json:"grid_secondaries,omitempty"`
+	AllowActiveDir      *[]interface{}            `json:"allow_active_dir,omitempty"`
+	AllowQuery          *[]interface{}            `json:"allow_query,omitempty"`
+	AllowTransfer       *[]interface{}            `json:"allow_transfer,omitempty"`
+	AllowUpdate         *[]interface{}            `json:"allow_update,omitempty"`
+}
+
+// zoneAuthResult is the generated ZoneAuth, which keeps the access control lists as they are returned by NIOS:
+// an entry may be either an access control entry or a named ACL's reference.
+type zoneAuthResult struct {
+	ibclient.ZoneAuth
+	AllowActiveDir []json.RawMessage `json:"allow_active_dir,omitempty"`
+	AllowQuery     []json.RawMessage `json:"allow_query,omitempty"`
+	AllowTransfer  []json.RawMessage `json:"allow_transfer,omitempty"`
+	AllowUpdate    []json.RawMessage `json:"allow_update,omitempty"`
+}
+
+func newEmptyZoneAuth() *ibclient.ZoneAuth {
+	obj := &ibclient.ZoneAuth{}
+	obj.SetReturnFields(zoneAuthReturnFields)
+
+	return obj
+}
+
 func resourceZoneAuth() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceZoneAuthCreate,
@@ -18,8 +62,9 @@ func resourceZoneAuth() *schema.Resource {
 		UpdateContext: resourceZoneAuthUpdate,
 		DeleteContext: resourceZoneAuthDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceZoneAuthImport,
+			StateContext: resourceZoneAuthImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -27,6 +72,16 @@ func resourceZoneAuth() *schema.Resource {
 					return err
 				}
 			}
+			for _, field := range []string{"allow_active_dir", "allow_query", "allow_transfer", "allow_update"} {
+				acl := d.Get(field).([]interface{})
+				if len(acl) == 0 || acl[0] == nil {
+					continue
+				}
+				accessList := acl[0].(map[string]interface{})["access_list"].([]interface{})
+				if err := validateAccessControlEntries(accessList); err != nil {
+					return fmt.Errorf("invalid '%s' field: %w", field, err)
+				}
+			}
 			return nil
 		},
 
@@ -65,13 +120,33 @@ func resourceZoneAuth() *schema.Resource {
 				Optional:    true,
 				Description: "The Extensible attributes of the zone, as a map in JSON format",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 
 			"ns_group": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The name server group that serves DNS for this zone.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: zoneAuthServerFields,
+				Description:   "The name server group that serves DNS for this zone.",
 			},
 
+			"grid_primary": memberServersSchema(
+				"The Grid members, which are the primary servers of the zone."),
+			"grid_secondaries": memberServersSchema(
+				"The Grid members, which are the secondary servers of the zone."),
+			"external_primaries": externalServersSchema(
+				"The name servers outside of the Grid, which are the primary servers of the zone."),
+			"external_secondaries": externalServersSchema(
+				"The name servers outside of the Grid, which are the secondary servers of the zone."),
+
+			"allow_active_dir": zoneAclSchema(
+				"The Active Directory servers, which are allowed to send the dynamic updates to the zone."),
+			"allow_query": zoneAclSchema(
+				"The clients, which are allowed or denied to query the zone."),
+			"allow_transfer": zoneAclSchema(
+				"The clients, which are allowed or denied to transfer the zone."),
+			"allow_update": zoneAclSchema(
+				"The clients, which are allowed or denied to send the dynamic updates to the zone."),
+
 			"restart_if_needed": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -136,27 +211,148 @@ func resourceZoneAuth() *schema.Resource {
 	}
 }
 
+// zoneAclSchema describes an access control list of the zone: either the access control entries
+// or the name of a named ACL.
+func zoneAclSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"named_acl": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "The name of the named ACL. Must not be set along with 'access_list'.",
+				},
+				"access_list": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The access control entries, in the order they are applied.",
+					Elem:        accessControlEntrySchema(),
+				},
+			},
+		},
+	}
+}
+
+// zoneAclFromResource makes the zone's access control list of the resource's block, and the flag, which
+// determines if the list is used; the list is empty and not used, if the block is not set.
+func zoneAclFromResource(acl []interface{}, m interface{}) (*[]interface{}, *bool, error) {
+	res := make([]interface{}, 0)
+	if len(acl) == 0 || acl[0] == nil {
+		return &res, utils.BoolPtr(false), nil
+	}
+
+	block := acl[0].(map[string]interface{})
+	namedAcl := block["named_acl"].(string)
+	accessList := block["access_list"].([]interface{})
+	switch {
+	case namedAcl == "" && len(accessList) == 0:
+		return nil, nil, fmt.Errorf("either 'named_acl' or 'access_list' must be set")
+	case namedAcl != "" && len(accessList) > 0:
+		return nil, nil, fmt.Errorf("'named_acl' and 'access_list' must not be set together")
+	case namedAcl != "":
+		var namedAcls []ibclient.Namedacl
+		qp := ibclient.NewQueryParams(false, map[string]string{"name": namedAcl})
+		if err := m.(ibclient.IBConnector).GetObject(&ibclient.Namedacl{}, "", qp, &namedAcls); err != nil {
+			return nil, nil, fmt.Errorf("failed to get named ACL '%s': %w", namedAcl, err)
+		}
+		if len(namedAcls) == 0 {
+			return nil, nil, fmt.Errorf("named ACL '%s' is not found", namedAcl)
+		}
+		res = append(res, namedAcls[0].Ref)
+	default:
+		entries, err := accessControlEntriesFromResource(accessList)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, e := range entries {
+			res = append(res, e)
+		}
+	}
+
+	return &res, utils.BoolPtr(true), nil
+}
+
+// setZoneAcl sets the zone's access control list, as the named ACL's name, if the list is a named ACL's reference.
+func setZoneAcl(d *schema.ResourceData, field string, use *bool, acl []json.RawMessage) error {
+	if use == nil || !*use || len(acl) == 0 {
+		return d.Set(field, []interface{}{})
+	}
+
+	var ref string
+	if len(acl) == 1 && json.Unmarshal(acl[0], &ref) == nil {
+		name := ref
+		if parts := strings.SplitN(ref, ":", 2); len(parts) == 2 {
+			name = parts[1]
+		}
+		return d.Set(field, []interface{}{map[string]interface{}{
+			"named_acl":   name,
+			"access_list": []interface{}{},
+		}})
+	}
+
+	entries := make([]*ibclient.Addressac, 0, len(acl))
+	for _, a := range acl {
+		var entry ibclient.Addressac
+		if err := json.Unmarshal(a, &entry); err != nil {
+			return fmt.Errorf("failed to read '%s' field: %w", field, err)
+		}
+		entries = append(entries, &entry)
+	}
+	var blocks []interface{}
+	if acls := d.Get(field).([]interface{}); len(acls) > 0 && acls[0] != nil {
+		blocks = acls[0].(map[string]interface{})["access_list"].([]interface{})
+	}
+
+	return d.Set(field, []interface{}{map[string]interface{}{
+		"named_acl":   "",
+		"access_list": convertAccessControlEntriesToInterface(blocks, entries),
+	}})
+}
+
+// setZoneAuthAcls sets the access control lists of the zone.
+func setZoneAuthAcls(d *schema.ResourceData, zone *zoneAuthResult) error {
+	if err := setZoneAcl(d, "allow_active_dir", zone.UseAllowActiveDir, zone.AllowActiveDir); err != nil {
+		return err
+	}
+	if err := setZoneAcl(d, "allow_query", zone.UseAllowQuery, zone.AllowQuery); err != nil {
+		return err
+	}
+	if err := setZoneAcl(d, "allow_transfer", zone.UseAllowTransfer, zone.AllowTransfer); err != nil {
+		return err
+	}
+
+	return setZoneAcl(d, "allow_update", zone.UseAllowUpdate, zone.AllowUpdate)
+}
+
 func checkZoneFormat(f string) diag.Diagnostics {
 	for _, v := range []string{"FORWARD", "IPV4", "IPV6"} {
 		if f == v {
 			return nil
 		}
 	}
-	return diag.FromErr(fmt.Errorf("zone's format must be either of FORWARD, IPV4, IPV6"))
+	return diagFromErr(fmt.Errorf("zone's format must be either of FORWARD, IPV4, IPV6"))
 }
 
 func formZone(
 	create bool, d *schema.ResourceData, m interface{}) (
-	*ibclient.ZoneAuth, diag.Diagnostics) {
+	*zoneAuthObject, diag.Diagnostics) {
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return nil, diag.FromErr(err)
+		return nil, diagFromErr(err)
 	}
 
-	zone := &ibclient.ZoneAuth{
-		Ea: extAttrs,
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	zone := &zoneAuthObject{
+		ZoneAuth: ibclient.ZoneAuth{
+			Ea: extAttrs,
+		},
 	}
 
 	if create {
@@ -189,6 +385,51 @@ func formZone(
 		zone.NsGroup = nil
 	}
 
+	// The name servers are sent only if they are changed, and ignored if the zone is served by a name server group.
+	if nsGrp == "" && d.HasChanges(zoneAuthServerFields...) {
+		gridPrimary := memberServersFromResource(d.Get("grid_primary").([]interface{}))
+		gridSecondaries := memberServersFromResource(d.Get("grid_secondaries").([]interface{}))
+		externalPrimaries := externalServersFromResource(d.Get("external_primaries").([]interface{}))
+		externalSecondaries := externalServersFromResource(d.Get("external_secondaries").([]interface{}))
+		if len(gridPrimary) > 0 && len(externalPrimaries) > 0 {
+			return nil, diagFromErr(fmt.Errorf("'grid_primary' and 'external_primaries' must not be set together"))
+		}
+
+		zone.GridPrimary = &gridPrimary
+		zone.GridSecondaries = &gridSecondaries
+		zone.ExternalPrimaries = &externalPrimaries
+		zone.ExternalSecondaries = &externalSecondaries
+		zone.UseExternalPrimary = utils.BoolPtr(len(externalPrimaries) > 0)
+	}
+
+	if d.HasChange("allow_active_dir") {
+		zone.AllowActiveDir, zone.UseAllowActiveDir, err = zoneAclFromResource(d.Get("allow_active_dir").([]interface{}), m)
+		if err != nil {
+			return nil, diagFromErr(fmt.Errorf("invalid 'allow_active_dir' field: %w", err))
+		}
+	}
+
+	if d.HasChange("allow_query") {
+		zone.AllowQuery, zone.UseAllowQuery, err = zoneAclFromResource(d.Get("allow_query").([]interface{}), m)
+		if err != nil {
+			return nil, diagFromErr(fmt.Errorf("invalid 'allow_query' field: %w", err))
+		}
+	}
+
+	if d.HasChange("allow_transfer") {
+		zone.AllowTransfer, zone.UseAllowTransfer, err = zoneAclFromResource(d.Get("allow_transfer").([]interface{}), m)
+		if err != nil {
+			return nil, diagFromErr(fmt.Errorf("invalid 'allow_transfer' field: %w", err))
+		}
+	}
+
+	if d.HasChange("allow_update") {
+		zone.AllowUpdate, zone.UseAllowUpdate, err = zoneAclFromResource(d.Get("allow_update").([]interface{}), m)
+		if err != nil {
+			return nil, diagFromErr(fmt.Errorf("invalid 'allow_update' field: %w", err))
+		}
+	}
+
 	if d.HasChange("restart_if_needed") {
 		zone.RestartIfNeeded = utils.BoolPtr(d.Get("restart_if_needed").(bool))
 	}
@@ -216,10 +457,39 @@ func formZone(
 	return zone, nil
 }
 
+// setZoneAuthServers sets the name servers of the zone, in the order of the resource's blocks. The name servers
+// of a zone, which is served by a name server group, are the group's ones, so they are not set.
+func setZoneAuthServers(d *schema.ResourceData, zone *ibclient.ZoneAuth) error {
+	if zone.NsGroup != nil && *zone.NsGroup != "" {
+		for _, field := range zoneAuthServerFields {
+			if err := d.Set(field, []interface{}{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := d.Set("grid_primary", orderServersAsBlocks(
+		d.Get("grid_primary").([]interface{}), convertMemberServersToInterface(zone.GridPrimary))); err != nil {
+		return err
+	}
+	if err := d.Set("grid_secondaries", orderServersAsBlocks(
+		d.Get("grid_secondaries").([]interface{}), convertMemberServersToInterface(zone.GridSecondaries))); err != nil {
+		return err
+	}
+	if err := d.Set("external_primaries", orderServersAsBlocks(
+		d.Get("external_primaries").([]interface{}), convertExternalServersToInterface(zone.ExternalPrimaries))); err != nil {
+		return err
+	}
+
+	return d.Set("external_secondaries", orderServersAsBlocks(
+		d.Get("external_secondaries").([]interface{}), convertExternalServersToInterface(zone.ExternalSecondaries)))
+}
+
 func resourceZoneAuthCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	zone, errs := formZone(true, d, m)
@@ -234,15 +504,15 @@ func resourceZoneAuthCreate(ctx context.Context, d *schema.ResourceData, m inter
 	connector := m.(ibclient.IBConnector)
 	zoneRef, err := connector.CreateObject(zone)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create a zone: %w", err))
+		return diagFromErr(fmt.Errorf("failed to create a zone: %w", err))
 	}
 
 	if err = d.Set("ref", zoneRef); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(zoneRef)
@@ -251,18 +521,17 @@ func resourceZoneAuthCreate(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var diags diag.Diagnostics
 
-	rec, err := searchObjectByRefOrInternalId("ZoneAuth", d, m)
-	if err != nil {
+	zoneResult := &zoneAuthResult{}
+	if err = getObjectByRefOrInternalId(newEmptyZoneAuth(), d, m, zoneResult); err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
 				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
@@ -270,24 +539,15 @@ func resourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 		}
 	}
 
-	// Assertion of object type and error handling
-	var zoneResult *ibclient.ZoneAuth
-	recJson, _ := json.Marshal(rec)
-	err = json.Unmarshal(recJson, &zoneResult)
-
-	if err != nil && zoneResult.Ref != "" {
-		return diag.FromErr(fmt.Errorf("getting DNS View with ID: %s failed: %w", d.Id(), err))
-	}
-
 	err = d.Set("fqdn", zoneResult.Fqdn)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if zoneResult.Comment != nil {
 		err = d.Set("comment", *zoneResult.Comment)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -297,73 +557,81 @@ func resourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 		err = d.Set("ns_group", "")
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+
+	if err = setZoneAuthServers(d, &zoneResult.ZoneAuth); err != nil {
+		return diagFromErr(err)
+	}
+
+	if err = setZoneAuthAcls(d, zoneResult); err != nil {
+		return diagFromErr(err)
 	}
 
 	if zoneResult.SoaDefaultTtl != nil {
 		err = d.Set("soa_default_ttl", *zoneResult.SoaDefaultTtl)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if zoneResult.SoaExpire != nil {
 		err = d.Set("soa_expire", *zoneResult.SoaExpire)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if zoneResult.SoaNegativeTtl != nil {
 		err = d.Set("soa_negative_ttl", *zoneResult.SoaNegativeTtl)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if zoneResult.SoaRefresh != nil {
 		err = d.Set("soa_refresh", *zoneResult.SoaRefresh)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if zoneResult.SoaRetry != nil {
 		err = d.Set("soa_retry", *zoneResult.SoaRetry)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if zoneResult.View != nil {
 		err = d.Set("view", *zoneResult.View)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	err = d.Set("zone_format", zoneResult.ZoneFormat)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	delete(zoneResult.Ea, eaNameForInternalId)
 
-	omittedEAs := omitEAs(zoneResult.Ea, extAttrs)
+	omittedEAs := omitEAs(zoneResult.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return diag.FromErr(err)
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	d.SetId(zoneResult.Ref)
 	if err = d.Set("ref", zoneResult.Ref); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return diags
@@ -371,19 +639,19 @@ func resourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 func resourceZoneAuthUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	if d.HasChange("internal_id") {
-		return diag.FromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 
 	if d.HasChange("fqdn") {
-		return diag.FromErr(fmt.Errorf("field is not allowed for update: fqdn"))
+		return diagFromErr(fmt.Errorf("field is not allowed for update: fqdn"))
 	}
 
 	if d.HasChange("zone_format") {
-		return diag.FromErr(fmt.Errorf("field is not allowed for update: zone_format"))
+		return diagFromErr(fmt.Errorf("field is not allowed for update: zone_format"))
 	}
 
 	if d.HasChange("view") {
-		return diag.FromErr(fmt.Errorf("field is not allowed for update: view"))
+		return diagFromErr(fmt.Errorf("field is not allowed for update: view"))
 	}
 
 	zone, errs := formZone(false, d, m)
@@ -391,16 +659,9 @@ func resourceZoneAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		return errs
 	}
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	connector := m.(ibclient.IBConnector)
@@ -408,7 +669,7 @@ func resourceZoneAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	rec, err := searchObjectByRefOrInternalId("ZoneAuth", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
 				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
@@ -422,7 +683,7 @@ func resourceZoneAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	err = json.Unmarshal(recJson, &zoneVal)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read Zone Auth for update operation: %w", err))
+		return diagFromErr(fmt.Errorf("failed to read Zone Auth for update operation: %w", err))
 	}
 
 	internalId := d.Get("internal_id").(string)
@@ -436,21 +697,21 @@ func resourceZoneAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 	zone.Ea, err = mergeEAs(zoneVal.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	zoneRef, err := connector.UpdateObject(zone, d.Id())
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update a zone: %w", err))
+		return diagFromErr(fmt.Errorf("failed to update a zone: %w", err))
 	}
 
 	d.SetId(zoneRef)
 
 	if err = d.Set("ref", zoneRef); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return resourceZoneAuthRead(ctx, d, m)
 }
@@ -461,7 +722,7 @@ func resourceZoneAuthDelete(ctx context.Context, d *schema.ResourceData, m inter
 	rec, err := searchObjectByRefOrInternalId("ZoneAuth", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
 				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
@@ -475,20 +736,19 @@ func resourceZoneAuthDelete(ctx context.Context, d *schema.ResourceData, m inter
 	err = json.Unmarshal(recJson, &zoneResult)
 
 	if err != nil && zoneResult.Ref != "" {
-		return diag.FromErr(fmt.Errorf("getting zone with ID: %s failed: %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("getting zone with ID: %s failed: %w", d.Id(), err))
 	}
 
 	if _, err := connector.DeleteObject(zoneResult.Ref); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceZoneAuthImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	_, err := terraformDeserializeEAs(extAttrJSON)
+func resourceZoneAuthImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	_, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -497,24 +757,9 @@ func resourceZoneAuthImport(d *schema.ResourceData, m interface{}) ([]*schema.Re
 
 	zoneRef := d.Id()
 
-	zoneResult := ibclient.ZoneAuth{}
-
-	zone := &ibclient.ZoneAuth{}
-	zone.SetReturnFields(append(
-		zoneResult.ReturnFields(),
-		"comment",
-		"ns_group",
-		"soa_default_ttl",
-		"soa_expire",
-		"soa_negative_ttl",
-		"soa_refresh",
-		"soa_retry",
-		"view",
-		"zone_format",
-		"extattrs",
-	))
-
-	err = connector.GetObject(zone, zoneRef, nil, &zoneResult)
+	zoneResult := zoneAuthResult{}
+
+	err = connector.GetObject(newEmptyZoneAuth(), zoneRef, nil, &zoneResult)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read zone: %w", err)
 	}
@@ -538,6 +783,14 @@ func resourceZoneAuthImport(d *schema.ResourceData, m interface{}) ([]*schema.Re
 		}
 	}
 
+	if err = setZoneAuthServers(d, &zoneResult.ZoneAuth); err != nil {
+		return nil, err
+	}
+
+	if err = setZoneAuthAcls(d, &zoneResult); err != nil {
+		return nil, err
+	}
+
 	if zoneResult.SoaDefaultTtl != nil {
 		err = d.Set("soa_default_ttl", *zoneResult.SoaDefaultTtl)
 		if err != nil {
@@ -586,22 +839,19 @@ func resourceZoneAuthImport(d *schema.ResourceData, m interface{}) ([]*schema.Re
 	}
 
 	if zoneResult.Ea != nil && len(zoneResult.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(zoneResult.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(zoneResult.Ea, m))
 		if err != nil {
 			return nil, err
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
 
 	d.SetId(zoneResult.Ref)
 
-	var dErr diag.Diagnostics
-	var ctx context.Context
-	dErr = resourceZoneAuthUpdate(ctx, d, m)
-	if dErr != nil {
-		return nil, fmt.Errorf("failed to import Zone Auth: %v", dErr)
+	if diags := resourceZoneAuthUpdate(ctx, d, m); diags.HasError() {
+		return nil, fmt.Errorf("failed to import Zone Auth: %w", diagsToError(diags))
 	}
 
 	return []*schema.ResourceData{d}, nil
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+	"reflect"
+	"regexp"
 	"testing"
 )
 
@@ -634,3 +636,189 @@ func TestAcc_resourceZoneAuth_ea_inheritance(t *testing.T) {
 		},
 	})
 }
+
+func TestOrderServersAsBlocks(t *testing.T) {
+	blocks := []interface{}{
+		map[string]interface{}{"name": "ns2.test.com", "address": "10.0.0.2", "tsig_key_name": "key2", "tsig_key": "c2VjcmV0"},
+		map[string]interface{}{"name": "ns1.test.com", "address": "10.0.0.1", "tsig_key_name": "", "tsig_key": ""},
+	}
+	servers := []map[string]interface{}{
+		{"name": "ns1.test.com", "address": "10.0.0.1", "tsig_key_name": "", "tsig_key": ""},
+		{"name": "ns3.test.com", "address": "10.0.0.3", "tsig_key_name": "", "tsig_key": ""},
+		{"name": "ns2.test.com", "address": "10.0.0.2", "tsig_key_name": "key2", "tsig_key": ""},
+	}
+	expected := []map[string]interface{}{
+		{"name": "ns2.test.com", "address": "10.0.0.2", "tsig_key_name": "key2", "tsig_key": "c2VjcmV0"},
+		{"name": "ns1.test.com", "address": "10.0.0.1", "tsig_key_name": "", "tsig_key": ""},
+		{"name": "ns3.test.com", "address": "10.0.0.3", "tsig_key_name": "", "tsig_key": ""},
+	}
+
+	if res := orderServersAsBlocks(blocks, servers); !reflect.DeepEqual(res, expected) {
+		t.Errorf("unexpected order of the name servers: %v", res)
+	}
+}
+
+func TestAccResourceZoneAuthServers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneAuthDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "servers" {
+						fqdn = "servers.test.com"
+						grid_primary {
+							name = "infoblox.localdomain"
+						}
+						external_secondaries {
+							name = "ns2.servers.test.com"
+							address = "10.0.0.2"
+						}
+						external_secondaries {
+							name = "ns1.servers.test.com"
+							address = "10.0.0.1"
+							stealth = true
+							tsig_key_name = "servers-key"
+							tsig_key = "c2VjcmV0LWtleQ=="
+							tsig_key_alg = "HMAC-SHA256"
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_auth.servers", "grid_primary.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.servers", "grid_primary.0.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.servers", "external_secondaries.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.servers", "external_secondaries.0.name", "ns2.servers.test.com"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.servers", "external_secondaries.1.stealth", "true"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.servers", "external_secondaries.1.tsig_key_name", "servers-key"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.servers", "external_secondaries.1.tsig_key_alg", "HMAC-SHA256"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.servers", "ns_group", ""),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "servers" {
+						fqdn = "servers.test.com"
+						external_primaries {
+							name = "ns1.servers.test.com"
+							address = "10.0.0.1"
+						}
+						grid_secondaries {
+							name = "infoblox.localdomain"
+							grid_replicate = true
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_auth.servers", "grid_primary.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.servers", "external_primaries.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.servers", "external_primaries.0.address", "10.0.0.1"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.servers", "grid_secondaries.0.grid_replicate", "true"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.servers", "external_secondaries.#", "0"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "servers" {
+						fqdn = "servers.test.com"
+						ns_group = "default"
+						grid_primary {
+							name = "infoblox.localdomain"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile(`conflicts with`),
+			},
+		},
+	})
+}
+
+func TestAccResourceZoneAuthAcls(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneAuthDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_named_acl" "transfer" {
+						name = "acc-test-zone-transfer-acl"
+						access_list {
+							address = "10.0.0.0/8"
+						}
+					}
+
+					resource "infoblox_zone_auth" "acls" {
+						fqdn = "acls.test.com"
+						allow_transfer {
+							named_acl = infoblox_named_acl.transfer.name
+						}
+						allow_query {
+							access_list {
+								address = "10.1.1.1"
+								permission = "DENY"
+							}
+							access_list {
+								address = "Any"
+							}
+						}
+						allow_update {
+							access_list {
+								tsig_key_name = "acls-key"
+								tsig_key = "c2VjcmV0LWtleQ=="
+							}
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acls", "allow_transfer.0.named_acl", "acc-test-zone-transfer-acl"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acls", "allow_query.0.access_list.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acls", "allow_query.0.access_list.0.permission", "DENY"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acls", "allow_query.0.access_list.1.address", "Any"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acls", "allow_update.0.access_list.0.tsig_key_name", "acls-key"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acls", "allow_active_dir.#", "0"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_named_acl" "transfer" {
+						name = "acc-test-zone-transfer-acl"
+						access_list {
+							address = "10.0.0.0/8"
+						}
+					}
+
+					resource "infoblox_zone_auth" "acls" {
+						fqdn = "acls.test.com"
+						allow_transfer {
+							access_list {
+								address = "10.2.0.0/16"
+							}
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acls", "allow_transfer.0.named_acl", ""),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acls", "allow_transfer.0.access_list.0.address", "10.2.0.0/16"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acls", "allow_query.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acls", "allow_update.#", "0"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "acls" {
+						fqdn = "acls.test.com"
+						allow_transfer {
+							named_acl = "acc-test-zone-transfer-acl"
+							access_list {
+								address = "10.2.0.0/16"
+							}
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile(`'named_acl' and 'access_list' must not be set together`),
+			},
+		},
+	})
+}
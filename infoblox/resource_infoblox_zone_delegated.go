@@ -1,21 +1,24 @@
 package infoblox
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func resourceZoneDelegated() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceZoneDelegatedCreate,
-		Read:   resourceZoneDelegatedRead,
-		Update: resourceZoneDelegatedUpdate,
-		Delete: resourceZoneDelegatedDelete,
+		CreateContext: resourceZoneDelegatedCreate,
+		ReadContext:   resourceZoneDelegatedRead,
+		UpdateContext: resourceZoneDelegatedUpdate,
+		DeleteContext: resourceZoneDelegatedDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceZoneDelegatedImport,
+			StateContext: resourceZoneDelegatedImport,
 		},
+		Timeouts: defaultTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"fqdn": {
 				Type:        schema.TypeString,
@@ -76,6 +79,7 @@ func resourceZoneDelegated() *schema.Resource {
 				Optional:    true,
 				Description: "Extensible attributes, as a map in JSON format",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 			"view": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -104,10 +108,10 @@ func resourceZoneDelegated() *schema.Resource {
 	}
 }
 
-func resourceZoneDelegatedCreate(d *schema.ResourceData, m interface{}) error {
+func resourceZoneDelegatedCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	fqdn := d.Get("fqdn").(string)
 
@@ -117,14 +121,14 @@ func resourceZoneDelegatedCreate(d *schema.ResourceData, m interface{}) error {
 	var delegateTo []ibclient.NameServer
 	var nullDT ibclient.NullableNameServers
 	if !nsGroupOk && !delegateToOk {
-		return fmt.Errorf("either 'ns_group' or 'delegate_to' must be set")
+		return diagFromErr(fmt.Errorf("either 'ns_group' or 'delegate_to' must be set"))
 	}
 	if delegateToOk {
 		dtSlice := dtInterface.([]interface{})
 		var err error
 		delegateTo, err = validateNameServers(dtSlice)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		nullDT = ibclient.NullableNameServers{IsNull: false, NameServers: delegateTo}
 	}
@@ -141,18 +145,19 @@ func resourceZoneDelegatedCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	view := d.Get("view").(string)
 	zoneFormat := d.Get("zone_format").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	// Generate internal ID and add it to the extensible attributes
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -167,33 +172,32 @@ func resourceZoneDelegatedCreate(d *schema.ResourceData, m interface{}) error {
 
 	newZoneDelegated, err := objMgr.CreateZoneDelegated(fqdn, nullDT, comment, disable, locked, nsGroup.(string), ttl, useTtl, extAttrs, view, zoneFormat)
 	if err != nil {
-		return fmt.Errorf("failed to create zone delegation : %s", err)
+		return diagFromErr(fmt.Errorf("failed to create zone delegation : %w", err))
 	}
 	d.SetId(newZoneDelegated.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", newZoneDelegated.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	return resourceZoneDelegatedRead(d, m)
+	return resourceZoneDelegatedRead(ctx, d, m)
 }
 
-func resourceZoneDelegatedRead(d *schema.ResourceData, m interface{}) error {
+func resourceZoneDelegatedRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
 	extAttrs := make(map[string]interface{})
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rec, err := searchObjectByRefOrInternalId("ZoneDelegated", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -203,23 +207,23 @@ func resourceZoneDelegatedRead(d *schema.ResourceData, m interface{}) error {
 	var zoneDelegated *ibclient.ZoneDelegated
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal zone delegated record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal zone delegated record : %w", err))
 	}
 	err = json.Unmarshal(recJson, &zoneDelegated)
 	if err != nil {
-		return fmt.Errorf("failed getting zone delegated record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting zone delegated record : %w", err))
 	}
 
 	delete(zoneDelegated.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(zoneDelegated.Ea, extAttrs)
+	omittedEAs := omitEAs(zoneDelegated.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
@@ -230,59 +234,59 @@ func resourceZoneDelegatedRead(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("delegated_ttl", ttl); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("fqdn", zoneDelegated.Fqdn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if zoneDelegated.View != nil {
 		if err := d.Set("view", *zoneDelegated.View); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if err := d.Set("zone_format", zoneDelegated.ZoneFormat); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if zoneDelegated.NsGroup != nil {
 		if err := d.Set("ns_group", *zoneDelegated.NsGroup); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err := d.Set("ns_group", ""); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if zoneDelegated.Comment != nil {
 		if err := d.Set("comment", *zoneDelegated.Comment); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if zoneDelegated.Disable != nil {
 		if err := d.Set("disable", *zoneDelegated.Disable); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if zoneDelegated.DelegateTo.NameServers != nil {
 		nsInterface := convertNullableNameServersToInterface(zoneDelegated.DelegateTo)
 		if err = d.Set("delegate_to", nsInterface); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err := d.Set("delegate_to", nil); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if zoneDelegated.Locked != nil {
 		if err := d.Set("locked", *zoneDelegated.Locked); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -290,7 +294,7 @@ func resourceZoneDelegatedRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceZoneDelegatedUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceZoneDelegatedUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -302,6 +306,7 @@ func resourceZoneDelegatedUpdate(d *schema.ResourceData, m interface{}) error {
 			prevNsGroup, _ := d.GetChange("ns_group")
 			prevDelegateTo, _ := d.GetChange("delegate_to")
 			prevExtAttrs, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 			prevTtl, _ := d.GetChange("delegated_ttl")
 
 			_ = d.Set("comment", prevComment.(string))
@@ -310,6 +315,7 @@ func resourceZoneDelegatedUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ns_group", prevNsGroup.(string))
 			_ = d.Set("delegate_to", prevDelegateTo)
 			_ = d.Set("ext_attrs", prevExtAttrs.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 			_ = d.Set("delegated_ttl", prevTtl.(int))
 		}
 	}()
@@ -318,22 +324,22 @@ func resourceZoneDelegatedUpdate(d *schema.ResourceData, m interface{}) error {
 	dtInterface, delegateToOk := d.GetOk("delegate_to")
 
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 	if d.HasChange("fqdn") {
-		return fmt.Errorf("changing the value of 'fqdn' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'fqdn' field is not allowed"))
 	}
 	if d.HasChange("view") {
-		return fmt.Errorf("changing the value of 'view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'view' field is not allowed"))
 	}
 	if d.HasChange("zone_format") {
-		return fmt.Errorf("changing the value of 'zone_format' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'zone_format' field is not allowed"))
 	}
 
 	var delegateTo []ibclient.NameServer
 	var nullDT ibclient.NullableNameServers
 	if !nsGroupOk && !delegateToOk {
-		return fmt.Errorf("either ns_group or delegate_to must be set")
+		return diagFromErr(fmt.Errorf("either ns_group or delegate_to must be set"))
 	} else if !delegateToOk {
 		nullDT = ibclient.NullableNameServers{IsNull: false, NameServers: []ibclient.NameServer{}}
 	} else {
@@ -341,21 +347,14 @@ func resourceZoneDelegatedUpdate(d *schema.ResourceData, m interface{}) error {
 		var err error
 		delegateTo, err = validateNameServers(dtSlice)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		nullDT = ibclient.NullableNameServers{IsNull: false, NameServers: delegateTo}
 	}
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -371,8 +370,8 @@ func resourceZoneDelegatedUpdate(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("ZoneDelegated", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -380,11 +379,11 @@ func resourceZoneDelegatedUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal zone delegated record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal zone delegated record : %w", err))
 	}
 	err = json.Unmarshal(recJson, &zoneDelegated)
 	if err != nil {
-		return fmt.Errorf("failed getting zone delegated record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting zone delegated record : %w", err))
 	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
@@ -397,7 +396,7 @@ func resourceZoneDelegatedUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(zoneDelegated.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	comment := d.Get("comment").(string)
@@ -417,32 +416,31 @@ func resourceZoneDelegatedUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diagFromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	zoneDelegated, err = objMgr.UpdateZoneDelegated(d.Id(), nullDT, comment, disable, locked, nsGroup, ttl, useTtl, newExtAttrs)
 	if err != nil {
-		return fmt.Errorf("Failed to update zone delegated with %s, ", err.Error())
+		return diagFromErr(fmt.Errorf("Failed to update zone delegated with %w, ", err))
 	}
 
 	updateSuccessful = true
 
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", zoneDelegated.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(zoneDelegated.Ref)
 
 	return nil
 }
 
-func resourceZoneDelegatedDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceZoneDelegatedDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -457,8 +455,8 @@ func resourceZoneDelegatedDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("ZoneDelegated", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -468,23 +466,22 @@ func resourceZoneDelegatedDelete(d *schema.ResourceData, m interface{}) error {
 	var zd *ibclient.ZoneDelegated
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal zone delegated record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal zone delegated record : %w", err))
 	}
 	err = json.Unmarshal(recJson, &zd)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	_, err = objMgr.DeleteZoneDelegated(zd.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to delete zone delegated : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to delete zone delegated : %w", err))
 	}
 
 	return nil
 }
 
-func resourceZoneDelegatedImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	_, err := terraformDeserializeEAs(extAttrJSON)
+func resourceZoneDelegatedImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	_, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -499,11 +496,11 @@ func resourceZoneDelegatedImport(d *schema.ResourceData, m interface{}) ([]*sche
 	}
 
 	if zoneDelegated.Ea != nil && len(zoneDelegated.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(zoneDelegated.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(zoneDelegated.Ea, m))
 		if err != nil {
 			return nil, err
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -576,9 +573,8 @@ func resourceZoneDelegatedImport(d *schema.ResourceData, m interface{}) ([]*sche
 	d.SetId(zoneDelegated.Ref)
 
 	// Update the resource with the EA Terraform Internal ID
-	err = resourceZoneDelegatedUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceZoneDelegatedUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
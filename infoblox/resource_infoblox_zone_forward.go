@@ -4,19 +4,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func resourceZoneForward() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceZoneForwardCreate,
-		Read:   resourceZoneForwardRead,
-		Update: resourceZoneForwardUpdate,
-		Delete: resourceZoneForwardDelete,
+		CreateContext: resourceZoneForwardCreate,
+		ReadContext:   resourceZoneForwardRead,
+		UpdateContext: resourceZoneForwardUpdate,
+		DeleteContext: resourceZoneForwardDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceZoneForwardImport,
+			StateContext: resourceZoneForwardImport,
 		},
+		Timeouts: defaultTimeouts(),
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
 				err := d.SetNewComputed("internal_id")
@@ -90,6 +92,7 @@ func resourceZoneForward() *schema.Resource {
 				Optional:    true,
 				Description: "Extensible attributes of the zone forward to be added/updated, as a map in JSON format.",
 			},
+			"extensible_attributes": extensibleAttributesSchema(),
 			"forwarders_only": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -158,10 +161,10 @@ func resourceZoneForward() *schema.Resource {
 
 }
 
-func resourceZoneForwardCreate(d *schema.ResourceData, m interface{}) error {
+func resourceZoneForwardCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	_, externalNsGroupOk := d.GetOk("external_ns_group")
@@ -171,18 +174,18 @@ func resourceZoneForwardCreate(d *schema.ResourceData, m interface{}) error {
 	var forwardTo []ibclient.NameServer
 	var nullFWT ibclient.NullableNameServers
 	if !externalNsGroupOk && !forwardToOk {
-		return fmt.Errorf("either external_ns_group or forward_to must be set")
+		return diagFromErr(fmt.Errorf("either external_ns_group or forward_to must be set"))
 	} else if !forwardToOk {
 		nullFWT = ibclient.NullableNameServers{IsNull: false, NameServers: []ibclient.NameServer{}}
 	} else {
 		ftSlice, ok := ftInterface.([]interface{})
 		if !ok {
-			return fmt.Errorf("forward_to is not a slice of Nameservers")
+			return diagFromErr(fmt.Errorf("forward_to is not a slice of Nameservers"))
 		}
 		var err error
 		forwardTo, err = validateNameServers(ftSlice)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		nullFWT = ibclient.NullableNameServers{IsNull: false, NameServers: forwardTo}
 	}
@@ -202,23 +205,24 @@ func resourceZoneForwardCreate(d *schema.ResourceData, m interface{}) error {
 	if forwardingServersOk {
 		fsSlice, ok := fsInterface.([]interface{})
 		if !ok {
-			return fmt.Errorf("forwarding_servers is not a slice of Forwardingmemberserver pointers")
+			return diagFromErr(fmt.Errorf("forwarding_servers is not a slice of Forwardingmemberserver pointers"))
 		}
 		forwardingServer, err := validateForwardingServers(fsSlice)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		nullFWS = &ibclient.NullableForwardingServers{IsNull: false, Servers: forwardingServer}
 	} else if !forwardingServersOk {
 		nullFWS = &ibclient.NullableForwardingServers{IsNull: false, Servers: []*ibclient.Forwardingmemberserver{}}
 	}
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
+	extAttrs = withProviderEAs(extAttrs, m)
+
 	// Generate internal ID and add it to the extensible attributes
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
@@ -233,27 +237,27 @@ func resourceZoneForwardCreate(d *schema.ResourceData, m interface{}) error {
 
 	newForwardZone, err := objMgr.CreateZoneForward(comment, disable, extAttrs, nullFWT, forwardersOnly, nullFWS, fqdn, nsGroup, view, zoneFormat, externalNsGroup)
 	if err != nil {
-		return fmt.Errorf("failed to create zone forward : %s", err)
+		return diagFromErr(fmt.Errorf("failed to create zone forward : %w", err))
 	}
 	d.SetId(newForwardZone.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", newForwardZone.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	return resourceZoneForwardRead(d, m)
+	return resourceZoneForwardRead(ctx, d, m)
 }
 
 func validateForwardingServers(fsSlice []interface{}) ([]*ibclient.Forwardingmemberserver, error) {
 	fsStr, err := json.Marshal(fsSlice)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal forwarding_servers: %s", err)
+		return nil, fmt.Errorf("failed to marshal forwarding_servers: %w", err)
 	}
 	var forwardingServer []*ibclient.Forwardingmemberserver
 	err = json.Unmarshal(fsStr, &forwardingServer)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal forwarding_servers: %s", err)
+		return nil, fmt.Errorf("failed to unmarshal forwarding_servers: %w", err)
 	}
 	return forwardingServer, nil
 }
@@ -261,28 +265,27 @@ func validateForwardingServers(fsSlice []interface{}) ([]*ibclient.Forwardingmem
 func validateNameServers(ftSlice []interface{}) ([]ibclient.NameServer, error) {
 	nsStr, err := json.Marshal(ftSlice)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal nameservers: %s", err)
+		return nil, fmt.Errorf("failed to marshal nameservers: %w", err)
 	}
 	var nameServers []ibclient.NameServer
 	err = json.Unmarshal(nsStr, &nameServers)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal nameservers: %s", err)
+		return nil, fmt.Errorf("failed to unmarshal nameservers: %w", err)
 	}
 	return nameServers, nil
 }
 
-func resourceZoneForwardRead(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceZoneForwardRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rec, err := searchObjectByRefOrInternalId("ZoneForward", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -291,91 +294,91 @@ func resourceZoneForwardRead(d *schema.ResourceData, m interface{}) error {
 	var zoneForward *ibclient.ZoneForward
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal zone forward record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal zone forward record : %w", err))
 	}
 	err = json.Unmarshal(recJson, &zoneForward)
 	if err != nil {
-		return fmt.Errorf("failed getting zone forward record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting zone forward record : %w", err))
 	}
 
 	delete(zoneForward.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(zoneForward.Ea, extAttrs)
+	omittedEAs := omitEAs(zoneForward.Ea, extAttrs, m)
 
 	if omittedEAs != nil && len(omittedEAs) > 0 {
 		eaJSON, err := terraformSerializeEAs(omittedEAs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if err := d.Set("fqdn", zoneForward.Fqdn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("external_ns_group", zoneForward.ExternalNsGroup); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if zoneForward.View != nil {
 		if err := d.Set("view", *zoneForward.View); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if err := d.Set("zone_format", zoneForward.ZoneFormat); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if zoneForward.NsGroup != nil {
 		if err := d.Set("ns_group", *zoneForward.NsGroup); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err := d.Set("ns_group", ""); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if zoneForward.Comment != nil {
 		if err := d.Set("comment", *zoneForward.Comment); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if zoneForward.Disable != nil {
 		if err := d.Set("disable", *zoneForward.Disable); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if zoneForward.ForwardersOnly != nil {
 		if err := d.Set("forwarders_only", *zoneForward.ForwardersOnly); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if zoneForward.ForwardTo.NameServers != nil {
 		nsInterface := convertNullableNameServersToInterface(zoneForward.ForwardTo)
 		if err = d.Set("forward_to", nsInterface); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err := d.Set("forward_to", nil); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if zoneForward.ForwardingServers.Servers != nil {
 		fwServerInterface, _ := convertForwardingServersToInterface(zoneForward.ForwardingServers.Servers)
 		if err := d.Set("forwarding_servers", fwServerInterface); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		if err := d.Set("forwarding_servers", nil); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -383,7 +386,7 @@ func resourceZoneForwardRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceZoneForwardUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -397,6 +400,7 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 			prevForwardTo, _ := d.GetChange("forward_to")
 			prevForwardingServers, _ := d.GetChange("forwarding_servers")
 			prevExtAttrs, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("disable", prevDisable.(bool))
@@ -406,6 +410,7 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("forward_to", prevForwardTo)
 			_ = d.Set("forwarding_servers", prevForwardingServers)
 			_ = d.Set("ext_attrs", prevExtAttrs.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
 		}
 	}()
 
@@ -414,47 +419,40 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 	ftInterface, forwardToOk := d.GetOk("forward_to")
 
 	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
 	}
 	if d.HasChange("fqdn") {
-		return fmt.Errorf("changing the value of 'fqdn' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'fqdn' field is not allowed"))
 	}
 	if d.HasChange("view") {
-		return fmt.Errorf("changing the value of 'view' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'view' field is not allowed"))
 	}
 	if d.HasChange("zone_format") {
-		return fmt.Errorf("changing the value of 'zone_format' field is not allowed")
+		return diagFromErr(fmt.Errorf("changing the value of 'zone_format' field is not allowed"))
 	}
 
 	var forwardTo []ibclient.NameServer
 	var nullFWT ibclient.NullableNameServers
 	if !externalNsGroupOk && !forwardToOk {
-		return fmt.Errorf("either external_ns_group or forward_to must be set")
+		return diagFromErr(fmt.Errorf("either external_ns_group or forward_to must be set"))
 	} else if !forwardToOk {
 		nullFWT = ibclient.NullableNameServers{IsNull: false, NameServers: []ibclient.NameServer{}}
 	} else {
 		ftSlice, ok := ftInterface.([]interface{})
 		if !ok {
-			return fmt.Errorf("forward_to is not a slice of Nameservers")
+			return diagFromErr(fmt.Errorf("forward_to is not a slice of Nameservers"))
 		}
 		var err error
 		forwardTo, err = validateNameServers(ftSlice)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		nullFWT = ibclient.NullableNameServers{IsNull: false, NameServers: forwardTo}
 	}
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -470,8 +468,8 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("ZoneForward", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -479,11 +477,11 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal zone forward record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal zone forward record : %w", err))
 	}
 	err = json.Unmarshal(recJson, &zf)
 	if err != nil {
-		return fmt.Errorf("failed getting zone forward record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed getting zone forward record : %w", err))
 	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
@@ -496,7 +494,7 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(zf.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	comment := d.Get("comment").(string)
@@ -521,11 +519,11 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 	if forwardingServersOk && d.HasChange("forwarding_servers") {
 		fsSlice, ok := fsInterface.([]interface{})
 		if !ok {
-			return fmt.Errorf("forwarding_servers is not a slice of Forwardingmemberserver pointers")
+			return diagFromErr(fmt.Errorf("forwarding_servers is not a slice of Forwardingmemberserver pointers"))
 		}
 		forwardingServer, err := validateForwardingServers(fsSlice)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		nullFWS = &ibclient.NullableForwardingServers{IsNull: false, Servers: forwardingServer}
 	} else if !forwardingServersOk && d.HasChange("forwarding_servers") {
@@ -536,27 +534,26 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 
 	zf, err = objMgr.UpdateZoneForward(d.Id(), comment, disable, newExtAttrs, nullFWT, forwardersOnly, nullFWS, nsGroup, externalNsGroup)
 	if err != nil {
-		return fmt.Errorf("Failed to update Zone Forward with %s, ", err.Error())
+		return diagFromErr(fmt.Errorf("Failed to update Zone Forward with %w, ", err))
 	}
 
 	updateSuccessful = true
 
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err = d.Set("ref", zf.Ref); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(zf.Ref)
 
 	return nil
 }
 
-func resourceZoneForwardDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceZoneForwardDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var tenantID string
@@ -571,8 +568,8 @@ func resourceZoneForwardDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("ZoneForward", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -582,24 +579,23 @@ func resourceZoneForwardDelete(d *schema.ResourceData, m interface{}) error {
 	var zf *ibclient.ZoneForward
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal zone forward record : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to marshal zone forward record : %w", err))
 	}
 	err = json.Unmarshal(recJson, &zf)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	_, err = objMgr.DeleteZoneForward(zf.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to delete Zone Forward : %s", err.Error())
+		return diagFromErr(fmt.Errorf("failed to delete Zone Forward : %w", err))
 	}
 
 	return nil
 }
 
-func resourceZoneForwardImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceZoneForwardImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	_, err := terraformDeserializeEAs(extAttrJSON)
+	_, err := terraformExtAttrs(d, m)
 	if err != nil {
 		return nil, err
 	}
@@ -613,11 +609,11 @@ func resourceZoneForwardImport(d *schema.ResourceData, m interface{}) ([]*schema
 	}
 
 	if zf.Ea != nil && len(zf.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(zf.Ea)
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(zf.Ea, m))
 		if err != nil {
 			return nil, err
 		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
 			return nil, err
 		}
 	}
@@ -692,9 +688,8 @@ func resourceZoneForwardImport(d *schema.ResourceData, m interface{}) ([]*schema
 	d.SetId(zf.Ref)
 
 	// Update the resource with the EA Terraform Internal ID
-	err = resourceZoneForwardUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceZoneForwardUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
@@ -0,0 +1,445 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var zoneRpReturnFields = []string{
+	"comment", "disable", "extattrs", "fqdn", "locked", "ns_group", "rpz_policy", "rpz_priority", "rpz_severity",
+	"rpz_type", "substitute_name", "view",
+}
+
+// zoneRpObject is the generated ZoneRp, which sends the substitute name and the name server group
+// even if they are empty, so that they are cleared on update.
+type zoneRpObject struct {
+	ibclient.ZoneRp
+	SubstituteName *string `json:"substitute_name"`
+	NsGroup        *string `json:"ns_group"`
+}
+
+func newEmptyZoneRp() *ibclient.ZoneRp {
+	obj := &ibclient.ZoneRp{}
+	obj.SetReturnFields(zoneRpReturnFields)
+
+	return obj
+}
+
+func resourceZoneRp() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZoneRpCreate,
+		ReadContext:   resourceZoneRpRead,
+		UpdateContext: resourceZoneRpUpdate,
+		DeleteContext: resourceZoneRpDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceZoneRpImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			if d.NewValueKnown("rpz_policy") && d.NewValueKnown("substitute_name") {
+				return validateRpzSubstituteName(d.Get("rpz_policy").(string), d.Get("substitute_name").(string))
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of this response policy zone.",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				Description: "The DNS view in which the zone is created.",
+			},
+			"rpz_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "GIVEN",
+				ValidateFunc: validation.StringInSlice([]string{
+					"GIVEN", "DISABLED", "NXDOMAIN", "NODATA", "PASSTHRU", "SUBSTITUTE"}, false),
+				Description: "The override policy of the zone: 'GIVEN' to apply the policies of the rules, " +
+					"'DISABLED' to log the matches only, or 'NXDOMAIN', 'NODATA', 'PASSTHRU' or 'SUBSTITUTE' " +
+					"to apply the policy to all the rules of the zone.",
+			},
+			"rpz_severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "MAJOR",
+				ValidateFunc: validation.StringInSlice([]string{"CRITICAL", "MAJOR", "WARNING", "INFORMATIONAL"}, false),
+				Description:  "The severity of the zone's rule hits, which are logged: 'CRITICAL', 'MAJOR', 'WARNING' or 'INFORMATIONAL'.",
+			},
+			"substitute_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The canonical name of the redirect target for the 'SUBSTITUTE' policy.",
+			},
+			"ns_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name server group that serves the zone.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A descriptive comment.",
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if the zone is disabled or not.",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if the zone is locked, so that other administrators cannot make conflicting changes.",
+			},
+			"rpz_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the zone: 'LOCAL', 'FEED' or 'FIREEYE'.",
+			},
+			"rpz_priority": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The priority of the zone among the response policy zones of the DNS view.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the zone to be added/updated, as a map in JSON format.",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+// validateRpzSubstituteName checks that the substitute name is set for the 'SUBSTITUTE' policy only.
+func validateRpzSubstituteName(policy, substituteName string) error {
+	if policy == "SUBSTITUTE" && substituteName == "" {
+		return fmt.Errorf("'substitute_name' field is required for the 'SUBSTITUTE' policy")
+	}
+	if policy != "SUBSTITUTE" && substituteName != "" {
+		return fmt.Errorf("'substitute_name' field is allowed for the 'SUBSTITUTE' policy only")
+	}
+
+	return nil
+}
+
+// zoneRpFromResource makes a response policy zone of the resource's fields,
+// except for the FQDN, the DNS view and the extensible attributes.
+func zoneRpFromResource(d *schema.ResourceData) (*zoneRpObject, error) {
+	policy := d.Get("rpz_policy").(string)
+	substituteName := d.Get("substitute_name").(string)
+	if err := validateRpzSubstituteName(policy, substituteName); err != nil {
+		return nil, err
+	}
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	locked := d.Get("locked").(bool)
+
+	obj := &zoneRpObject{
+		ZoneRp: ibclient.ZoneRp{
+			RpzPolicy:   policy,
+			RpzSeverity: d.Get("rpz_severity").(string),
+			Comment:     &comment,
+			Disable:     &disable,
+			Locked:      &locked,
+		},
+		SubstituteName: &substituteName,
+	}
+	// A null name server group removes the zone's one.
+	if nsGroup := d.Get("ns_group").(string); nsGroup != "" {
+		obj.NsGroup = &nsGroup
+	}
+
+	return obj, nil
+}
+
+func setZoneRpFields(d *schema.ResourceData, obj *ibclient.ZoneRp) error {
+	if err := d.Set("fqdn", obj.Fqdn); err != nil {
+		return err
+	}
+	if err := d.Set("view", stringPtrValue(obj.View)); err != nil {
+		return err
+	}
+	if err := d.Set("rpz_policy", obj.RpzPolicy); err != nil {
+		return err
+	}
+	if err := d.Set("rpz_severity", obj.RpzSeverity); err != nil {
+		return err
+	}
+	if err := d.Set("substitute_name", stringPtrValue(obj.SubstituteName)); err != nil {
+		return err
+	}
+	if err := d.Set("ns_group", stringPtrValue(obj.NsGroup)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", stringPtrValue(obj.Comment)); err != nil {
+		return err
+	}
+	if err := d.Set("disable", obj.Disable != nil && *obj.Disable); err != nil {
+		return err
+	}
+	if err := d.Set("locked", obj.Locked != nil && *obj.Locked); err != nil {
+		return err
+	}
+	if err := d.Set("rpz_type", obj.RpzType); err != nil {
+		return err
+	}
+
+	return d.Set("rpz_priority", int(obj.RpzPriority))
+}
+
+func resourceZoneRpCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Check if internal_id is set manually
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	obj, err := zoneRpFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	view := d.Get("view").(string)
+	obj.Fqdn = d.Get("fqdn").(string)
+	obj.View = &view
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	obj.Ea = extAttrs
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(obj)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to create response policy zone: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceZoneRpRead(ctx, d, m)
+}
+
+func resourceZoneRpRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var obj ibclient.ZoneRp
+	if err = getObjectByRefOrInternalId(newEmptyZoneRp(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setZoneRpFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceZoneRpUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure, in the state file.
+		if !updateSuccessful {
+			prevPolicy, _ := d.GetChange("rpz_policy")
+			prevSeverity, _ := d.GetChange("rpz_severity")
+			prevSubstituteName, _ := d.GetChange("substitute_name")
+			prevNsGroup, _ := d.GetChange("ns_group")
+			prevComment, _ := d.GetChange("comment")
+			prevDisable, _ := d.GetChange("disable")
+			prevLocked, _ := d.GetChange("locked")
+			prevExtAttrs, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("rpz_policy", prevPolicy.(string))
+			_ = d.Set("rpz_severity", prevSeverity.(string))
+			_ = d.Set("substitute_name", prevSubstituteName.(string))
+			_ = d.Set("ns_group", prevNsGroup.(string))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("disable", prevDisable.(bool))
+			_ = d.Set("locked", prevLocked.(bool))
+			_ = d.Set("ext_attrs", prevExtAttrs.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+	if d.HasChange("fqdn") {
+		return diagFromErr(fmt.Errorf("changing the value of 'fqdn' field is not allowed"))
+	}
+	if d.HasChange("view") {
+		return diagFromErr(fmt.Errorf("changing the value of 'view' field is not allowed"))
+	}
+
+	obj, err := zoneRpFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var found ibclient.ZoneRp
+	if err = getObjectByRefOrInternalId(newEmptyZoneRp(), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	obj.Ea, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := connector.UpdateObject(obj, found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to update response policy zone: %w", err))
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceZoneRpRead(ctx, d, m)
+}
+
+func resourceZoneRpDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.ZoneRp
+	if err := getObjectByRefOrInternalId(newEmptyZoneRp(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err := m.(ibclient.IBConnector).DeleteObject(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("failed to delete response policy zone: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceZoneRpImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	var obj ibclient.ZoneRp
+	err := m.(ibclient.IBConnector).GetObject(newEmptyZoneRp(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting response policy zone: %w", err)
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setZoneRpFields(d, &obj); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceZoneRpUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,100 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckZoneRpDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_zone_rp" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.ZoneRp
+		err := connector.GetObject(newEmptyZoneRp(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("response policy zone %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceZoneRp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneRpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_rp" "rpz1" {
+						fqdn    = "blocklist.rpz.test"
+						comment = "DNS firewall block list"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz1", "fqdn", "blocklist.rpz.test"),
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz1", "view", "default"),
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz1", "rpz_policy", "GIVEN"),
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz1", "rpz_severity", "MAJOR"),
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz1", "rpz_type", "LOCAL"),
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz1", "comment", "DNS firewall block list"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_rp" "rpz1" {
+						fqdn            = "blocklist.rpz.test"
+						rpz_policy      = "SUBSTITUTE"
+						rpz_severity    = "CRITICAL"
+						substitute_name = "walled-garden.example.com"
+						locked          = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz1", "rpz_policy", "SUBSTITUTE"),
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz1", "rpz_severity", "CRITICAL"),
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz1", "substitute_name", "walled-garden.example.com"),
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz1", "locked", "true"),
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz1", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "infoblox_zone_rp.rpz1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+			{
+				Config: `
+					resource "infoblox_zone_rp" "rpz1" {
+						fqdn         = "blocklist.rpz.test"
+						rpz_policy   = "GIVEN"
+						rpz_severity = "CRITICAL"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz1", "rpz_policy", "GIVEN"),
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz1", "substitute_name", ""),
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz1", "locked", "false"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_rp" "rpz1" {
+						fqdn       = "blocklist.rpz.test"
+						rpz_policy = "SUBSTITUTE"
+					}`,
+				ExpectError: regexp.MustCompile("'substitute_name' field is required"),
+			},
+		},
+	})
+}
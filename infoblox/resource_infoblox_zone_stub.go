@@ -0,0 +1,524 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var zoneStubReturnFields = []string{
+	"comment", "disable", "disable_forwarding", "extattrs", "external_ns_group", "fqdn", "locked", "ns_group",
+	"parent", "prefix", "stub_from", "stub_members", "view", "zone_format",
+}
+
+// zoneStubObject is the generated ZoneStub, which sends empty lists of primaries and stub members,
+// instead of omitting them, so that they may be replaced by the name server groups on update.
+// The name server groups are sent as null, when the lists are used instead, so that they are cleared.
+type zoneStubObject struct {
+	ibclient.ZoneStub
+	StubFrom        *[]ibclient.NameServer    `json:"stub_from,omitempty"`
+	StubMembers     *[]*ibclient.Memberserver `json:"stub_members,omitempty"`
+	ExternalNsGroup *string                   `json:"external_ns_group"`
+	NsGroup         *string                   `json:"ns_group"`
+}
+
+func newEmptyZoneStub() *ibclient.ZoneStub {
+	obj := &ibclient.ZoneStub{}
+	obj.SetReturnFields(zoneStubReturnFields)
+
+	return obj
+}
+
+func resourceZoneStub() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZoneStubCreate,
+		ReadContext:   resourceZoneStubRead,
+		UpdateContext: resourceZoneStubUpdate,
+		DeleteContext: resourceZoneStubDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceZoneStubImport,
+		},
+		Timeouts: defaultTimeouts(),
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of this DNS zone. For a reverse zone, this is in 'address/cidr' format.",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				Description: "The DNS view in which the zone is created.",
+			},
+			"zone_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "FORWARD",
+				ValidateFunc: validation.StringInSlice([]string{"FORWARD", "IPV4", "IPV6"}, false),
+				Description:  "The format of the zone. Valid values are: FORWARD, IPV4, IPV6.",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The RFC2317 prefix of an IPv4 reverse zone, with the netmask between 25 and 31 bits.",
+			},
+			"stub_from": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The primary servers (masters) of this stub zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IP address of the primary server.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the primary server.",
+						},
+					},
+				},
+			},
+			"external_ns_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A forward stub server name server group, which provides the primary servers of this stub zone.",
+			},
+			"stub_members": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The Grid member servers of this stub zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the Grid member in FQDN format.",
+						},
+					},
+				},
+			},
+			"ns_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A stub member name server group, which provides the Grid member servers of this stub zone.",
+			},
+			"disable_forwarding": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if the name servers of the zone should not forward the zone's queries to the configured forwarders.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A descriptive comment.",
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if the zone is disabled or not.",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if the zone is locked, so that other administrators cannot make conflicting changes.",
+			},
+			"parent": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The parent zone of this zone.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the zone stub to be added/updated, as a map in JSON format.",
+			},
+			"extensible_attributes": extensibleAttributesSchema(),
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+func convertStubMembersToInterface(members []*ibclient.Memberserver) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(members))
+	for _, m := range members {
+		res = append(res, map[string]interface{}{
+			"name": m.Name,
+		})
+	}
+
+	return res
+}
+
+// zoneStubFromResource makes a stub zone of the resource's fields,
+// except for the FQDN, the DNS view, the zone format and the extensible attributes.
+func zoneStubFromResource(d *schema.ResourceData) (*zoneStubObject, error) {
+	stubFrom, err := validateNameServers(d.Get("stub_from").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	externalNsGroup := d.Get("external_ns_group").(string)
+	switch {
+	case len(stubFrom) == 0 && externalNsGroup == "":
+		return nil, fmt.Errorf("either 'stub_from' or 'external_ns_group' must be set")
+	case len(stubFrom) > 0 && externalNsGroup != "":
+		return nil, fmt.Errorf("'stub_from' and 'external_ns_group' must not be set together")
+	}
+
+	stubMembers := make([]*ibclient.Memberserver, 0)
+	for _, m := range d.Get("stub_members").([]interface{}) {
+		member, ok := m.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("'name' field is required for a stub member")
+		}
+		stubMembers = append(stubMembers, &ibclient.Memberserver{Name: member["name"].(string)})
+	}
+	nsGroup := d.Get("ns_group").(string)
+	switch {
+	case len(stubMembers) == 0 && nsGroup == "":
+		return nil, fmt.Errorf("either 'stub_members' or 'ns_group' must be set")
+	case len(stubMembers) > 0 && nsGroup != "":
+		return nil, fmt.Errorf("'stub_members' and 'ns_group' must not be set together")
+	}
+
+	comment := d.Get("comment").(string)
+	disable := d.Get("disable").(bool)
+	disableForwarding := d.Get("disable_forwarding").(bool)
+	locked := d.Get("locked").(bool)
+
+	obj := &zoneStubObject{
+		ZoneStub: ibclient.ZoneStub{
+			Comment:           &comment,
+			Disable:           &disable,
+			DisableForwarding: &disableForwarding,
+			Locked:            &locked,
+		},
+	}
+	// The RFC2317 prefix is valid for IPv4 reverse zones only.
+	if d.Get("zone_format").(string) == "IPV4" {
+		prefix := d.Get("prefix").(string)
+		obj.Prefix = &prefix
+	} else if d.Get("prefix").(string) != "" {
+		return nil, fmt.Errorf("'prefix' field is allowed for the IPV4 zone format only")
+	}
+	if externalNsGroup != "" {
+		obj.ExternalNsGroup = &externalNsGroup
+	} else {
+		obj.StubFrom = &stubFrom
+	}
+	if nsGroup != "" {
+		obj.NsGroup = &nsGroup
+	} else {
+		obj.StubMembers = &stubMembers
+	}
+
+	return obj, nil
+}
+
+func setZoneStubFields(d *schema.ResourceData, obj *ibclient.ZoneStub) error {
+	if err := d.Set("fqdn", obj.Fqdn); err != nil {
+		return err
+	}
+	if err := d.Set("view", stringPtrValue(obj.View)); err != nil {
+		return err
+	}
+	if err := d.Set("zone_format", obj.ZoneFormat); err != nil {
+		return err
+	}
+	if err := d.Set("prefix", stringPtrValue(obj.Prefix)); err != nil {
+		return err
+	}
+	if err := d.Set("stub_from", convertNullableNameServersToInterface(
+		ibclient.NullableNameServers{NameServers: obj.StubFrom})); err != nil {
+		return err
+	}
+	if err := d.Set("external_ns_group", stringPtrValue(obj.ExternalNsGroup)); err != nil {
+		return err
+	}
+	if err := d.Set("stub_members", convertStubMembersToInterface(obj.StubMembers)); err != nil {
+		return err
+	}
+	if err := d.Set("ns_group", stringPtrValue(obj.NsGroup)); err != nil {
+		return err
+	}
+	if err := d.Set("disable_forwarding", obj.DisableForwarding != nil && *obj.DisableForwarding); err != nil {
+		return err
+	}
+	if err := d.Set("comment", stringPtrValue(obj.Comment)); err != nil {
+		return err
+	}
+	if err := d.Set("disable", obj.Disable != nil && *obj.Disable); err != nil {
+		return err
+	}
+	if err := d.Set("locked", obj.Locked != nil && *obj.Locked); err != nil {
+		return err
+	}
+
+	return d.Set("parent", obj.Parent)
+}
+
+func resourceZoneStubCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Check if internal_id is set manually
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diagFromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	obj, err := zoneStubFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	view := d.Get("view").(string)
+	obj.Fqdn = d.Get("fqdn").(string)
+	obj.View = &view
+	obj.ZoneFormat = d.Get("zone_format").(string)
+
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	extAttrs = withProviderEAs(extAttrs, m)
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	obj.Ea = extAttrs
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(obj)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to create zone stub: %w", err))
+	}
+	d.SetId(ref)
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceZoneStubRead(ctx, d, m)
+}
+
+func resourceZoneStubRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformExtAttrs(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var obj ibclient.ZoneStub
+	if err = getObjectByRefOrInternalId(newEmptyZoneStub(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(obj.Ea, extAttrs, m)
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if err = setZoneStubFields(d, &obj); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceZoneStubUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure, in the state file.
+		if !updateSuccessful {
+			prevPrefix, _ := d.GetChange("prefix")
+			prevStubFrom, _ := d.GetChange("stub_from")
+			prevExternalNsGroup, _ := d.GetChange("external_ns_group")
+			prevStubMembers, _ := d.GetChange("stub_members")
+			prevNsGroup, _ := d.GetChange("ns_group")
+			prevDisableForwarding, _ := d.GetChange("disable_forwarding")
+			prevComment, _ := d.GetChange("comment")
+			prevDisable, _ := d.GetChange("disable")
+			prevLocked, _ := d.GetChange("locked")
+			prevExtAttrs, _ := d.GetChange("ext_attrs")
+			prevEaBlocks, _ := d.GetChange("extensible_attributes")
+
+			_ = d.Set("prefix", prevPrefix.(string))
+			_ = d.Set("stub_from", prevStubFrom)
+			_ = d.Set("external_ns_group", prevExternalNsGroup.(string))
+			_ = d.Set("stub_members", prevStubMembers)
+			_ = d.Set("ns_group", prevNsGroup.(string))
+			_ = d.Set("disable_forwarding", prevDisableForwarding.(bool))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("disable", prevDisable.(bool))
+			_ = d.Set("locked", prevLocked.(bool))
+			_ = d.Set("ext_attrs", prevExtAttrs.(string))
+			_ = d.Set("extensible_attributes", prevEaBlocks)
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return diagFromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+	if d.HasChange("fqdn") {
+		return diagFromErr(fmt.Errorf("changing the value of 'fqdn' field is not allowed"))
+	}
+	if d.HasChange("view") {
+		return diagFromErr(fmt.Errorf("changing the value of 'view' field is not allowed"))
+	}
+	if d.HasChange("zone_format") {
+		return diagFromErr(fmt.Errorf("changing the value of 'zone_format' field is not allowed"))
+	}
+
+	obj, err := zoneStubFromResource(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	oldExtAttrs, newExtAttrs, err := terraformExtAttrsChange(d, m)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var found ibclient.ZoneStub
+	if err = getObjectByRefOrInternalId(newEmptyZoneStub(), d, m, &found); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	obj.Ea, err = mergeEAs(found.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	ref, err := connector.UpdateObject(obj, found.Ref)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to update zone stub: %w", err))
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceZoneStubRead(ctx, d, m)
+}
+
+func resourceZoneStubDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var obj ibclient.ZoneStub
+	if err := getObjectByRefOrInternalId(newEmptyZoneStub(), d, m, &obj); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return diagFromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err := m.(ibclient.IBConnector).DeleteObject(obj.Ref); err != nil {
+		return diagFromErr(fmt.Errorf("failed to delete zone stub: %w", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceZoneStubImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	var obj ibclient.ZoneStub
+	err := m.(ibclient.IBConnector).GetObject(newEmptyZoneStub(), d.Id(), ibclient.NewQueryParams(false, nil), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting zone stub: %w", err)
+	}
+
+	delete(obj.Ea, eaNameForInternalId)
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(omitProviderEAs(obj.Ea, m))
+		if err != nil {
+			return nil, err
+		}
+		if err = setTerraformExtAttrs(d, eaJSON); err != nil {
+			return nil, err
+		}
+	}
+	if err = setZoneStubFields(d, &obj); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ref", obj.Ref); err != nil {
+		return nil, err
+	}
+	d.SetId(obj.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if diags := resourceZoneStubUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,199 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckZoneStubDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_zone_stub" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var obj ibclient.ZoneStub
+		err := connector.GetObject(newEmptyZoneStub(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &obj)
+		if err == nil {
+			return fmt.Errorf("zone stub %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceZoneStub(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneStubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_stub" "zone1" {
+						fqdn = "partner.stub.test.com"
+						stub_from {
+							name    = "ns1.partner.com"
+							address = "10.0.0.1"
+						}
+						stub_from {
+							name    = "ns2.partner.com"
+							address = "10.0.0.2"
+						}
+						stub_members {
+							name = "infoblox.localdomain"
+						}
+						comment = "partner stub zone"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "fqdn", "partner.stub.test.com"),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "view", "default"),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "zone_format", "FORWARD"),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "stub_from.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "stub_from.1.address", "10.0.0.2"),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "stub_members.0.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "comment", "partner stub zone"),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "locked", "false"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_stub" "zone1" {
+						fqdn = "partner.stub.test.com"
+						stub_from {
+							name    = "ns3.partner.com"
+							address = "10.0.0.3"
+						}
+						stub_members {
+							name = "infoblox.localdomain"
+						}
+						disable_forwarding = true
+						disable            = true
+						locked             = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "stub_from.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "stub_from.0.name", "ns3.partner.com"),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "disable_forwarding", "true"),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "disable", "true"),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "locked", "true"),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "infoblox_zone_stub.zone1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"internal_id"},
+			},
+		},
+	})
+}
+
+func TestAccResourceZoneStubReverse(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneStubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_stub" "zone1" {
+						fqdn        = "10.20.30.0/24"
+						zone_format = "IPV4"
+						stub_from {
+							name    = "ns1.partner.com"
+							address = "10.0.0.1"
+						}
+						stub_members {
+							name = "infoblox.localdomain"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "fqdn", "10.20.30.0/24"),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "zone_format", "IPV4"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_stub" "zone1" {
+						fqdn        = "10.20.30.0/24"
+						zone_format = "IPV4"
+						stub_from {
+							name    = "ns1.partner.com"
+							address = "10.0.0.1"
+						}
+						stub_members {
+							name = "infoblox.localdomain"
+						}
+						ns_group = "stub-group"
+					}`,
+				ExpectError: regexp.MustCompile("'stub_members' and 'ns_group' must not be set together"),
+			},
+		},
+	})
+}
+
+func TestAccResourceZoneStubNsGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneStubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ns_group_stub_member" "nsg1" {
+						name = "acc-test-stub-zone-group"
+						stub_members {
+							name = "infoblox.localdomain"
+						}
+					}
+
+					resource "infoblox_zone_stub" "zone1" {
+						fqdn = "group.stub.test.com"
+						stub_from {
+							name    = "ns1.partner.com"
+							address = "10.0.0.1"
+						}
+						ns_group = infoblox_ns_group_stub_member.nsg1.name
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "ns_group", "acc-test-stub-zone-group"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ns_group_stub_member" "nsg1" {
+						name = "acc-test-stub-zone-group"
+						stub_members {
+							name = "infoblox.localdomain"
+						}
+					}
+
+					resource "infoblox_zone_stub" "zone1" {
+						fqdn = "group.stub.test.com"
+						stub_from {
+							name    = "ns1.partner.com"
+							address = "10.0.0.1"
+						}
+						stub_members {
+							name = "infoblox.localdomain"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "ns_group", ""),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "stub_members.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.zone1", "stub_members.0.name", "infoblox.localdomain"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,155 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// The go-client reports unsuccessful WAPI responses as plain errors of the form
+// "WAPI request error: <status code>('<status>')\nContents:\n<response body>\n".
+var wapiErrorRegExp = regexp.MustCompile(`(?s)^WAPI request error: (\d+)\('([^']*)'\)\nContents:\n(.*)\n$`)
+
+// wapiError is an unsuccessful WAPI response.
+type wapiError struct {
+	StatusCode int
+	Status     string
+
+	// The fields of the JSON body of a WAPI error response.
+	Code    string // ex. 'Client.Ibap.Data.Conflict'
+	Text    string // a human-readable message
+	Message string // the full NIOS error, ex. 'AdmConDataError: None (IBDataConflictError: ...)'
+
+	// The body of the response, as is.
+	Contents string
+
+	// The attribute of the resource which has sent the request, which has caused the error, if known.
+	attrPath cty.Path
+}
+
+// newWapiError parses an error returned by the go-client's requestor. Not found errors
+// and errors which are not caused by a WAPI response (ex. transport errors) are returned as is.
+func newWapiError(err error) error {
+	if err == nil || isNotFoundError(err) {
+		return err
+	}
+	match := wapiErrorRegExp.FindStringSubmatch(err.Error())
+	if match == nil {
+		return err
+	}
+
+	res := &wapiError{Status: match[2], Contents: match[3]}
+	res.StatusCode, _ = strconv.Atoi(match[1])
+
+	var body struct {
+		Error string `json:"Error"`
+		Code  string `json:"code"`
+		Text  string `json:"text"`
+	}
+	if json.Unmarshal([]byte(res.Contents), &body) == nil {
+		res.Code = body.Code
+		res.Text = strings.TrimSpace(body.Text)
+		res.Message = strings.TrimSpace(body.Error)
+	}
+
+	return res
+}
+
+func (e *wapiError) Error() string {
+	switch {
+	case e.Text != "":
+		return e.Text
+	case e.Message != "":
+		return e.Message
+	}
+
+	return fmt.Sprintf("WAPI request error: %d('%s')\nContents:\n%s\n", e.StatusCode, e.Status, e.Contents)
+}
+
+// detail describes the error in full, for a diagnostic's detail.
+func (e *wapiError) detail() string {
+	status := e.Status
+	if status == "" {
+		status = strconv.Itoa(e.StatusCode)
+	}
+	lines := []string{fmt.Sprintf("NIOS rejected the request with the status '%s'.", status)}
+	if e.Code != "" {
+		lines = append(lines, "Code: "+e.Code)
+	}
+	if e.Message != "" && e.Message != e.Text {
+		lines = append(lines, "Error: "+e.Message)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// wapiErrorRequestor turns unsuccessful WAPI responses into wapiError values.
+type wapiErrorRequestor struct {
+	ibclient.HttpRequestor
+}
+
+func (r *wapiErrorRequestor) SendRequest(req *http.Request) ([]byte, error) {
+	res, err := r.HttpRequestor.SendRequest(req)
+	if err != nil {
+		return nil, newWapiError(err)
+	}
+
+	return res, nil
+}
+
+var ipAddrAttributes = []string{"ip_addr", "ipv4_addr", "ipv6_addr", "ipv4addr", "ipv6addr", "start_addr"}
+
+// wapiErrorAttributes lists the attributes which may cause known kinds of WAPI errors, in the order of preference.
+var wapiErrorAttributes = []struct {
+	pattern    *regexp.Regexp
+	attributes []string
+}{
+	{regexp.MustCompile(`(?i)\bnetwork\b.*\b(already exists|overlaps?)\b`), []string{"cidr", "network"}},
+	{regexp.MustCompile(`(?i)not (in|within) (a|any|the)( configured)? network|outside (of )?(the |any )?network|cannot find a network|does not belong to`), ipAddrAttributes},
+	{regexp.MustCompile(`(?i)\b(ip|fixed) address\b.*\b(already exists|in use|reserved)\b`), ipAddrAttributes},
+	{regexp.MustCompile(`(?i)already exists|duplicate`), []string{"fqdn", "name", "record_name"}},
+	{regexp.MustCompile(`(?i)extensible attribute`), []string{"ext_attrs"}},
+	{regexp.MustCompile(`(?i)network ?view`), []string{"network_view"}},
+	{regexp.MustCompile(`(?i)\bdns ?view|\bview\b`), []string{"dns_view", "view"}},
+	{regexp.MustCompile(`(?i)\bttl\b`), []string{"ttl"}},
+}
+
+// attributePath infers the resource's attribute which has caused the error, if any.
+func (e *wapiError) attributePath(resourceSchema map[string]*schema.Schema) cty.Path {
+	msg := e.Text + "\n" + e.Message
+	for _, known := range wapiErrorAttributes {
+		if !known.pattern.MatchString(msg) {
+			continue
+		}
+		for _, attr := range known.attributes {
+			if _, found := resourceSchema[attr]; found {
+				return cty.GetAttrPath(attr)
+			}
+		}
+	}
+
+	return nil
+}
+
+// diagFromErr makes the error diagnostics of an operation out of an error. The details of the WAPI error
+// the error has been caused by, if any, and the attribute which has caused it are added to the diagnostics.
+func diagFromErr(err error) diag.Diagnostics {
+	diags := diag.FromErr(err)
+
+	var wapiErr *wapiError
+	if errors.As(err, &wapiErr) {
+		diags[0].Detail = wapiErr.detail()
+		diags[0].AttributePath = wapiErr.attrPath
+	}
+
+	return diags
+}
@@ -0,0 +1,138 @@
+package infoblox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+func TestWapiError(t *testing.T) {
+	err := newWapiError(testWapiError(http.StatusBadRequest, `{ "Error": "AdmConDataError: None (IBDataConflictError: IB.Data.Conflict:The record 'a.test.com' already exists.)",
+  "code": "Client.Ibap.Data.Conflict",
+  "text": "The record 'a.test.com' already exists."
+}`))
+
+	var wapiErr *wapiError
+	if !errors.As(err, &wapiErr) {
+		t.Fatalf("expected a WAPI error, got %T", err)
+	}
+	if wapiErr.StatusCode != http.StatusBadRequest || wapiErr.Code != "Client.Ibap.Data.Conflict" {
+		t.Fatalf("unexpected WAPI error fields: %+v", wapiErr)
+	}
+	if err.Error() != "The record 'a.test.com' already exists." {
+		t.Fatalf("unexpected error message: %s", err.Error())
+	}
+	if wapiErrorStatusCode(fmt.Errorf("wrapped: %w", err)) != http.StatusBadRequest {
+		t.Fatal("expected the status code of a wrapped WAPI error")
+	}
+
+	// A response which is not a WAPI error keeps the original message.
+	err = newWapiError(testWapiError(http.StatusBadGateway, "<html>Bad Gateway</html>"))
+	if !strings.Contains(err.Error(), "<html>Bad Gateway</html>") || wapiErrorStatusCode(err) != http.StatusBadGateway {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Not found errors and transport errors are not changed.
+	notFoundErr := ibclient.NewNotFoundError("not found")
+	if newWapiError(notFoundErr) != notFoundErr {
+		t.Fatal("expected a not found error to be returned as is")
+	}
+	transportErr := errors.New("connection refused")
+	if newWapiError(transportErr) != transportErr {
+		t.Fatal("expected a transport error to be returned as is")
+	}
+}
+
+func TestWapiErrorDetails(t *testing.T) {
+	cases := []struct {
+		text         string
+		attributes   []string
+		expectedPath cty.Path
+	}{
+		{"The record 'a.test.com' already exists.", []string{"fqdn", "ip_addr"}, cty.GetAttrPath("fqdn")},
+		{"The record 'a.test.com' already exists.", []string{"name", "ipv4addr"}, cty.GetAttrPath("name")},
+		{"The IP address 10.1.1.5 is not in any configured network.", []string{"name", "ipv4addr"}, cty.GetAttrPath("ipv4addr")},
+		{"The network 10.1.1.0/24 already exists.", []string{"cidr", "network_view"}, cty.GetAttrPath("cidr")},
+		{"The view 'internal' does not exist.", []string{"fqdn", "dns_view"}, cty.GetAttrPath("dns_view")},
+		{"Invalid permission.", []string{"fqdn"}, nil},
+	}
+
+	for i, tc := range cases {
+		resourceSchema := make(map[string]*schema.Schema)
+		for _, attr := range tc.attributes {
+			resourceSchema[attr] = &schema.Schema{Type: schema.TypeString, Optional: true}
+		}
+
+		wapiErr := newWapiError(testWapiError(http.StatusBadRequest,
+			fmt.Sprintf(`{"Error": "AdmConDataError: %s", "code": "Client.Ibap.Data", "text": "%s"}`, tc.text, tc.text)))
+		requestor := &contextRequestor{
+			HttpRequestor:  &testRequestor{errs: []error{wapiErr}},
+			ctx:            context.Background(),
+			resourceSchema: resourceSchema,
+		}
+		_, err := requestor.SendRequest(&http.Request{Method: http.MethodPost})
+
+		diags := diagFromErr(fmt.Errorf("creation of the object failed: %w", err))
+		if !strings.Contains(diags[0].Detail, "Code: Client.Ibap.Data") {
+			t.Fatalf("test case %d: expected the details of the WAPI error, got '%s'", i, diags[0].Detail)
+		}
+		if !diags[0].AttributePath.Equals(tc.expectedPath) {
+			t.Fatalf("test case %d: expected the attribute path %#v, got %#v", i, tc.expectedPath, diags[0].AttributePath)
+		}
+	}
+
+	// Errors which are not caused by a WAPI response have no details.
+	diags := diagFromErr(fmt.Errorf("creation of the object failed: %w", errors.New("connection refused")))
+	if diags[0].Detail != "" || diags[0].AttributePath != nil {
+		t.Fatalf("unexpected diagnostic: %+v", diags[0])
+	}
+}
+
+func TestWapiErrorDiagnostics(t *testing.T) {
+	conflictErr := newWapiError(testWapiError(http.StatusBadRequest,
+		`{"Error": "AdmConDataError: None (IBDataConflictError: IB.Data.Conflict:The record 'a.test.com' already exists.)",
+		"code": "Client.Ibap.Data.Conflict", "text": "The record 'a.test.com' already exists."}`))
+	// The go-client sends a failed request once again, through the grid master.
+	inner := &testRequestor{errs: []error{conflictErr, conflictErr}}
+	conn := &providerConnector{
+		hostConfig: ibclient.HostConfig{Host: "nios.example.com", Port: "443", Version: "2.12.3"},
+		requestor:  inner,
+	}
+
+	resource := withRequestContext(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"fqdn": {Type: schema.TypeString, Required: true},
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			recA := ibclient.NewEmptyRecordA()
+			recA.Name = utils.StringPtr("a.test.com")
+			if _, err := m.(ibclient.IBConnector).CreateObject(recA); err != nil {
+				return diagFromErr(fmt.Errorf("creation of A-record failed: %w", err))
+			}
+			return nil
+		},
+	})
+
+	diags := resource.CreateContext(context.Background(), nil, conn)
+	if len(diags) != 1 {
+		t.Fatalf("expected a single diagnostic, got %v", diags)
+	}
+	if diags[0].Summary != "creation of A-record failed: The record 'a.test.com' already exists." {
+		t.Fatalf("unexpected summary: %s", diags[0].Summary)
+	}
+	if !strings.Contains(diags[0].Detail, "Client.Ibap.Data.Conflict") {
+		t.Fatalf("unexpected detail: %s", diags[0].Detail)
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("fqdn")) {
+		t.Fatalf("expected the diagnostic to point at 'fqdn', got %#v", diags[0].AttributePath)
+	}
+}